@@ -0,0 +1,155 @@
+// Package conditions manages metav1.Condition lists of runtime objects. Status
+// conditions can be accessed either through objects implementing Getter and
+// Setter or through plain slices, e.g. the conditions of a status struct,
+// using FromSlice.
+package conditions
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Getter is implemented by types exposing a list of conditions, usually
+// runtime objects exposing the conditions of their status.
+type Getter interface {
+	GetConditions() []metav1.Condition
+}
+
+// Setter is implemented by types whose list of conditions can be modified,
+// usually runtime objects modifying the conditions of their status.
+type Setter interface {
+	Getter
+	SetConditions(conditions []metav1.Condition)
+}
+
+// generationGetter is implemented by runtime objects. Conditions set on them
+// are stamped with their generation.
+type generationGetter interface {
+	GetGeneration() int64
+}
+
+type slice struct {
+	conditions *[]metav1.Condition
+}
+
+// FromSlice returns a Setter modifying the given list of conditions in place,
+// e.g. &obj.Status.Conditions.
+func FromSlice(conditions *[]metav1.Condition) Setter {
+	return &slice{conditions: conditions}
+}
+
+func (s *slice) GetConditions() []metav1.Condition {
+	return *s.conditions
+}
+
+func (s *slice) SetConditions(conditions []metav1.Condition) {
+	*s.conditions = conditions
+}
+
+// Get returns a copy of the condition of the given type, if any.
+func Get(from Getter, t string) (metav1.Condition, bool) {
+	c := meta.FindStatusCondition(from.GetConditions(), t)
+	if c == nil {
+		return metav1.Condition{}, false
+	}
+
+	return *c, true
+}
+
+// IsTrue checks whether the condition of the given type exists and its status
+// is true.
+func IsTrue(from Getter, t string) bool {
+	return meta.IsStatusConditionTrue(from.GetConditions(), t)
+}
+
+// IsFalse checks whether the condition of the given type exists and its status
+// is false.
+func IsFalse(from Getter, t string) bool {
+	return meta.IsStatusConditionFalse(from.GetConditions(), t)
+}
+
+// IsUnknown checks whether the condition of the given type is missing or its
+// status is unknown.
+func IsUnknown(from Getter, t string) bool {
+	c, ok := Get(from, t)
+	return !ok || c.Status == metav1.ConditionUnknown
+}
+
+// Set adds the given condition or replaces the existing condition of the same
+// type. The last transition time of existing conditions is preserved unless
+// the status changes. Conditions set on runtime objects are stamped with their
+// current generation unless the given condition specifies an observed
+// generation. Set returns true in case the conditions changed.
+func Set(to Setter, c metav1.Condition) bool {
+	if c.LastTransitionTime.IsZero() {
+		c.LastTransitionTime = metav1.NewTime(time.Now().UTC().Truncate(time.Second))
+	}
+	if c.ObservedGeneration == 0 {
+		g, ok := to.(generationGetter)
+		if ok {
+			c.ObservedGeneration = g.GetGeneration()
+		}
+	}
+
+	conditions := copyConditions(to.GetConditions())
+
+	changed := meta.SetStatusCondition(&conditions, c)
+	if changed {
+		to.SetConditions(conditions)
+	}
+
+	return changed
+}
+
+// MarkTrue sets the condition of the given type to true. Reason must be a
+// non-empty CamelCase string as required by the Kubernetes API.
+func MarkTrue(to Setter, t, reason, message string) bool {
+	return Set(to, newCondition(t, metav1.ConditionTrue, reason, message))
+}
+
+// MarkFalse sets the condition of the given type to false. Reason must be a
+// non-empty CamelCase string as required by the Kubernetes API.
+func MarkFalse(to Setter, t, reason, message string) bool {
+	return Set(to, newCondition(t, metav1.ConditionFalse, reason, message))
+}
+
+// MarkUnknown sets the condition of the given type to unknown. Reason must be
+// a non-empty CamelCase string as required by the Kubernetes API.
+func MarkUnknown(to Setter, t, reason, message string) bool {
+	return Set(to, newCondition(t, metav1.ConditionUnknown, reason, message))
+}
+
+// Delete removes the condition of the given type, if any. Delete returns true
+// in case the conditions changed.
+func Delete(to Setter, t string) bool {
+	conditions := copyConditions(to.GetConditions())
+
+	removed := meta.RemoveStatusCondition(&conditions, t)
+	if removed {
+		to.SetConditions(conditions)
+	}
+
+	return removed
+}
+
+func copyConditions(conditions []metav1.Condition) []metav1.Condition {
+	if conditions == nil {
+		return nil
+	}
+
+	c := make([]metav1.Condition, len(conditions))
+	copy(c, conditions)
+
+	return c
+}
+
+func newCondition(t string, status metav1.ConditionStatus, reason, message string) metav1.Condition {
+	return metav1.Condition{
+		Type:    t,
+		Status:  status,
+		Reason:  reason,
+		Message: message,
+	}
+}
@@ -0,0 +1,188 @@
+package conditions
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_Conditions_Set(t *testing.T) {
+	past := metav1.NewTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	testCases := []struct {
+		name                       string
+		conditions                 []metav1.Condition
+		condition                  metav1.Condition
+		expectedChanged            bool
+		expectedStatus             metav1.ConditionStatus
+		expectedReason             string
+		expectedLastTransitionTime func(t metav1.Time) bool
+	}{
+		{
+			name:            "case 0: missing conditions are added",
+			conditions:      nil,
+			condition:       metav1.Condition{Type: "Deployed", Status: metav1.ConditionTrue, Reason: "Deployed"},
+			expectedChanged: true,
+			expectedStatus:  metav1.ConditionTrue,
+			expectedReason:  "Deployed",
+			expectedLastTransitionTime: func(t metav1.Time) bool {
+				return !t.IsZero() && !t.Equal(&past)
+			},
+		},
+		{
+			name: "case 1: the last transition time is preserved when the status does not change",
+			conditions: []metav1.Condition{
+				{Type: "Deployed", Status: metav1.ConditionTrue, Reason: "Deployed", LastTransitionTime: past},
+			},
+			condition:       metav1.Condition{Type: "Deployed", Status: metav1.ConditionTrue, Reason: "Upgraded"},
+			expectedChanged: true,
+			expectedStatus:  metav1.ConditionTrue,
+			expectedReason:  "Upgraded",
+			expectedLastTransitionTime: func(t metav1.Time) bool {
+				return t.Equal(&past)
+			},
+		},
+		{
+			name: "case 2: the last transition time is updated when the status changes",
+			conditions: []metav1.Condition{
+				{Type: "Deployed", Status: metav1.ConditionTrue, Reason: "Deployed", LastTransitionTime: past},
+			},
+			condition:       metav1.Condition{Type: "Deployed", Status: metav1.ConditionFalse, Reason: "Failed"},
+			expectedChanged: true,
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  "Failed",
+			expectedLastTransitionTime: func(t metav1.Time) bool {
+				return !t.IsZero() && !t.Equal(&past)
+			},
+		},
+		{
+			name: "case 3: equal conditions are not changed",
+			conditions: []metav1.Condition{
+				{Type: "Deployed", Status: metav1.ConditionTrue, Reason: "Deployed", LastTransitionTime: past},
+			},
+			condition:       metav1.Condition{Type: "Deployed", Status: metav1.ConditionTrue, Reason: "Deployed"},
+			expectedChanged: false,
+			expectedStatus:  metav1.ConditionTrue,
+			expectedReason:  "Deployed",
+			expectedLastTransitionTime: func(t metav1.Time) bool {
+				return t.Equal(&past)
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			conditions := copyConditions(tc.conditions)
+
+			changed := Set(FromSlice(&conditions), tc.condition)
+			if changed != tc.expectedChanged {
+				t.Fatalf("changed == %v, want %v", changed, tc.expectedChanged)
+			}
+
+			c, ok := Get(FromSlice(&conditions), tc.condition.Type)
+			if !ok {
+				t.Fatalf("condition %#q missing", tc.condition.Type)
+			}
+			if c.Status != tc.expectedStatus {
+				t.Fatalf("status == %#q, want %#q", c.Status, tc.expectedStatus)
+			}
+			if c.Reason != tc.expectedReason {
+				t.Fatalf("reason == %#q, want %#q", c.Reason, tc.expectedReason)
+			}
+			if !tc.expectedLastTransitionTime(c.LastTransitionTime) {
+				t.Fatalf("unexpected last transition time %s", c.LastTransitionTime)
+			}
+		})
+	}
+}
+
+func Test_Conditions_SetSummary(t *testing.T) {
+	testCases := []struct {
+		name            string
+		conditions      []metav1.Condition
+		types           []string
+		expectedStatus  metav1.ConditionStatus
+		expectedReason  string
+		expectedMessage string
+	}{
+		{
+			name: "case 0: ready is true when all conditions are true",
+			conditions: []metav1.Condition{
+				{Type: "Deployed", Status: metav1.ConditionTrue, Reason: "Deployed"},
+				{Type: "Healthy", Status: metav1.ConditionTrue, Reason: "Healthy"},
+			},
+			expectedStatus:  metav1.ConditionTrue,
+			expectedReason:  ReasonReady,
+			expectedMessage: "",
+		},
+		{
+			name: "case 1: ready is false when any condition is false",
+			conditions: []metav1.Condition{
+				{Type: "Deployed", Status: metav1.ConditionUnknown, Reason: "Deploying"},
+				{Type: "Healthy", Status: metav1.ConditionFalse, Reason: "Unhealthy", Message: "Pods are crashing."},
+			},
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  "Unhealthy",
+			expectedMessage: "Healthy: Pods are crashing.",
+		},
+		{
+			name: "case 2: ready is unknown when any condition is unknown",
+			conditions: []metav1.Condition{
+				{Type: "Deployed", Status: metav1.ConditionUnknown, Reason: "Deploying"},
+				{Type: "Healthy", Status: metav1.ConditionTrue, Reason: "Healthy"},
+			},
+			expectedStatus:  metav1.ConditionUnknown,
+			expectedReason:  "Deploying",
+			expectedMessage: "Condition Deployed is Unknown.",
+		},
+		{
+			name: "case 3: ready is unknown when a given condition is missing",
+			conditions: []metav1.Condition{
+				{Type: "Deployed", Status: metav1.ConditionTrue, Reason: "Deployed"},
+			},
+			types:           []string{"Deployed", "Healthy"},
+			expectedStatus:  metav1.ConditionUnknown,
+			expectedReason:  ReasonConditionMissing,
+			expectedMessage: "Healthy: Condition Healthy is missing.",
+		},
+		{
+			name: "case 4: ready is only computed from the given conditions",
+			conditions: []metav1.Condition{
+				{Type: "Deployed", Status: metav1.ConditionTrue, Reason: "Deployed"},
+				{Type: "Healthy", Status: metav1.ConditionFalse, Reason: "Unhealthy"},
+			},
+			types:           []string{"Deployed"},
+			expectedStatus:  metav1.ConditionTrue,
+			expectedReason:  ReasonReady,
+			expectedMessage: "",
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			conditions := copyConditions(tc.conditions)
+
+			SetSummary(FromSlice(&conditions), tc.types...)
+
+			c, ok := Get(FromSlice(&conditions), Ready)
+			if !ok {
+				t.Fatalf("condition %#q missing", Ready)
+			}
+			if c.Status != tc.expectedStatus {
+				t.Fatalf("status == %#q, want %#q", c.Status, tc.expectedStatus)
+			}
+			if c.Reason != tc.expectedReason {
+				t.Fatalf("reason == %#q, want %#q", c.Reason, tc.expectedReason)
+			}
+			if c.Message != tc.expectedMessage {
+				t.Fatalf("message == %#q, want %#q", c.Message, tc.expectedMessage)
+			}
+		})
+	}
+}
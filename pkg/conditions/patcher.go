@@ -0,0 +1,108 @@
+package conditions
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/giantswarm/microerror"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Object is a runtime object exposing the conditions of its status.
+type Object interface {
+	client.Object
+	Setter
+}
+
+type PatcherConfig struct {
+	CtrlClient client.Client
+}
+
+// Patcher patches the status subresource of runtime objects without
+// overwriting concurrent changes. Status changes are expressed as mutate
+// functions which are applied to the latest version of the runtime object
+// fetched from the Kubernetes API. The resulting merge patch is sent using
+// optimistic locking. In case of conflicts the latest version is fetched
+// again and the mutate function reapplied, so that conditions and other
+// status fields managed by others are retained.
+type Patcher struct {
+	ctrlClient client.Client
+}
+
+func NewPatcher(config PatcherConfig) (*Patcher, error) {
+	if config.CtrlClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.CtrlClient must not be empty", config)
+	}
+
+	p := &Patcher{
+		ctrlClient: config.CtrlClient,
+	}
+
+	return p, nil
+}
+
+// Patch applies mutate to the latest version of obj and patches its status
+// subresource accordingly. Nothing is sent in case mutate does not change
+// anything. On success obj is updated to the patched version. mutate may be
+// called multiple times and must therefore be idempotent.
+func (p *Patcher) Patch(ctx context.Context, obj client.Object, mutate func(obj client.Object) error) error {
+	var latest client.Object
+
+	o := func() error {
+		latest = obj.DeepCopyObject().(client.Object)
+		err := p.ctrlClient.Get(ctx, client.ObjectKeyFromObject(obj), latest)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		original := latest.DeepCopyObject().(client.Object)
+
+		err = mutate(latest)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		if equality.Semantic.DeepEqual(original, latest) {
+			return nil
+		}
+
+		err = p.ctrlClient.Status().Patch(ctx, latest, client.MergeFromWithOptions(original, client.MergeFromWithOptimisticLock{}))
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		return nil
+	}
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, o)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	reflect.ValueOf(obj).Elem().Set(reflect.ValueOf(latest).Elem())
+
+	return nil
+}
+
+// PatchConditions sets the given conditions on the latest version of obj and
+// patches its status subresource accordingly. Conditions of other types are
+// retained. See Set for how the given conditions are set.
+func (p *Patcher) PatchConditions(ctx context.Context, obj Object, conditions ...metav1.Condition) error {
+	mutate := func(latest client.Object) error {
+		for _, c := range conditions {
+			Set(latest.(Object), c)
+		}
+
+		return nil
+	}
+
+	err := p.Patch(ctx, obj, mutate)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package conditions
+
+import (
+	"context"
+	"testing"
+
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func Test_Patcher_Patch_Conflict(t *testing.T) {
+	ctx := context.Background()
+
+	obj := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+		},
+	}
+
+	// The first status patch is preceded by a concurrent status update of
+	// another condition, which causes a conflict.
+	var patches int
+	ctrlClient := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(obj).
+		WithStatusSubresource(obj).
+		WithInterceptorFuncs(interceptor.Funcs{
+			SubResourcePatch: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+				patches++
+				if patches == 1 {
+					var concurrent policyv1.PodDisruptionBudget
+					err := c.Get(ctx, client.ObjectKeyFromObject(obj), &concurrent)
+					if err != nil {
+						return err
+					}
+					MarkTrue(FromSlice(&concurrent.Status.Conditions), "Concurrent", "Concurrent", "")
+					err = c.Status().Update(ctx, &concurrent)
+					if err != nil {
+						return err
+					}
+				}
+
+				return c.SubResource(subResourceName).Patch(ctx, obj, patch, opts...)
+			},
+		}).
+		Build()
+
+	p, err := NewPatcher(PatcherConfig{CtrlClient: ctrlClient})
+	if err != nil {
+		t.Fatalf("error == %#v, want nil", err)
+	}
+
+	mutate := func(o client.Object) error {
+		pdb := o.(*policyv1.PodDisruptionBudget)
+		MarkFalse(FromSlice(&pdb.Status.Conditions), Ready, "Failed", "Something failed.")
+		return nil
+	}
+
+	err = p.Patch(ctx, obj, mutate)
+	if err != nil {
+		t.Fatalf("error == %#v, want nil", err)
+	}
+
+	if patches != 2 {
+		t.Fatalf("patches == %d, want %d", patches, 2)
+	}
+
+	var latest policyv1.PodDisruptionBudget
+	err = ctrlClient.Get(ctx, client.ObjectKeyFromObject(obj), &latest)
+	if err != nil {
+		t.Fatalf("error == %#v, want nil", err)
+	}
+
+	for _, o := range []*policyv1.PodDisruptionBudget{obj, &latest} {
+		if !IsTrue(FromSlice(&o.Status.Conditions), "Concurrent") {
+			t.Fatalf("condition %#q == %#v, want true", "Concurrent", o.Status.Conditions)
+		}
+		if !IsFalse(FromSlice(&o.Status.Conditions), Ready) {
+			t.Fatalf("condition %#q == %#v, want false", Ready, o.Status.Conditions)
+		}
+	}
+
+	// Patching the same status again must not send anything.
+	err = p.Patch(ctx, obj, mutate)
+	if err != nil {
+		t.Fatalf("error == %#v, want nil", err)
+	}
+	if patches != 2 {
+		t.Fatalf("patches == %d, want %d", patches, 2)
+	}
+}
@@ -0,0 +1,82 @@
+package conditions
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// Ready is the type of the summary condition computed by SetSummary.
+	Ready = "Ready"
+)
+
+const (
+	// ReasonConditionMissing is the reason of the summary condition in case a
+	// condition it is computed from does not exist.
+	ReasonConditionMissing = "ConditionMissing"
+	// ReasonNotReady is the reason of the summary condition in case the
+	// condition causing it not to be true does not specify any reason.
+	ReasonNotReady = "NotReady"
+	// ReasonReady is the reason of the summary condition in case all
+	// conditions it is computed from are true.
+	ReasonReady = "Ready"
+)
+
+// SetSummary sets the Ready condition summarizing the conditions of the given
+// types, or all other conditions in case no types are given. Ready is true in
+// case all of them are true. Otherwise Ready is false in case any of them is
+// false, and unknown in case any of them is unknown or missing. Reason and
+// message are then taken from the first condition causing Ready not to be
+// true, in the order of the given types or the existing conditions
+// respectively. SetSummary returns true in case the conditions changed.
+func SetSummary(to Setter, types ...string) bool {
+	if len(types) == 0 {
+		for _, c := range to.GetConditions() {
+			if c.Type != Ready {
+				types = append(types, c.Type)
+			}
+		}
+	}
+
+	var unknown *metav1.Condition
+	for _, t := range types {
+		c, ok := Get(to, t)
+		if !ok {
+			c = newCondition(t, metav1.ConditionUnknown, ReasonConditionMissing, fmt.Sprintf("Condition %s is missing.", t))
+		}
+
+		switch c.Status {
+		case metav1.ConditionTrue:
+			continue
+		case metav1.ConditionFalse:
+			return MarkFalse(to, Ready, summaryReason(c), summaryMessage(c))
+		default:
+			if unknown == nil {
+				unknown = &c
+			}
+		}
+	}
+
+	if unknown != nil {
+		return MarkUnknown(to, Ready, summaryReason(*unknown), summaryMessage(*unknown))
+	}
+
+	return MarkTrue(to, Ready, ReasonReady, "")
+}
+
+func summaryMessage(c metav1.Condition) string {
+	if c.Message == "" {
+		return fmt.Sprintf("Condition %s is %s.", c.Type, c.Status)
+	}
+
+	return fmt.Sprintf("%s: %s", c.Type, c.Message)
+}
+
+func summaryReason(c metav1.Condition) string {
+	if c.Reason == "" {
+		return ReasonNotReady
+	}
+
+	return c.Reason
+}
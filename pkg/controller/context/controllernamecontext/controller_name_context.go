@@ -0,0 +1,28 @@
+// Package controllernamecontext stores and accesses the name of the
+// reconciling controller in context.Context.
+package controllernamecontext
+
+import (
+	"context"
+)
+
+// key is an unexported type for keys defined in this package. This prevents
+// collisions with keys defined in other packages.
+type key string
+
+// ctxKey is the key for controller name values in context.Context. Clients
+// use controllernamecontext.NewContext and controllernamecontext.FromContext
+// instead of using this key directly.
+var ctxKey key = "controller-name"
+
+// NewContext returns a new context.Context that carries the name of the
+// controller executing the current reconciliation loop.
+func NewContext(ctx context.Context, v string) context.Context {
+	return context.WithValue(ctx, ctxKey, v)
+}
+
+// FromContext returns the controller name, if any.
+func FromContext(ctx context.Context) (string, bool) {
+	v, ok := ctx.Value(ctxKey).(string)
+	return v, ok
+}
@@ -0,0 +1,13 @@
+package controllernamecontext
+
+import (
+	"context"
+	"testing"
+)
+
+func Test_Controller_ControllerNameContext(t *testing.T) {
+	s, _ := FromContext(NewContext(context.Background(), "test"))
+	if s != "test" {
+		t.Fatalf("expected %#q got %#q", "test", s)
+	}
+}
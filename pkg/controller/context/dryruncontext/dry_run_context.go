@@ -0,0 +1,83 @@
+// Package dryruncontext stores and accesses the dry-run plan in
+// context.Context.
+package dryruncontext
+
+import (
+	"context"
+	"sync"
+)
+
+// key is an unexported type for keys defined in this package. This prevents
+// collisions with keys defined in other packages.
+type key string
+
+// dryRunKey is the key for dry-run plan values in context.Context. Clients
+// use dryruncontext.NewContext and dryruncontext.FromContext instead of using
+// this key directly.
+var dryRunKey key = "dryrun"
+
+// Change describes a change which a resource would have applied if the
+// reconciliation was not executed in dry-run mode.
+type Change struct {
+	// Resource is the name of the resource which computed the change.
+	Resource string
+	// Operation is either "create", "delete" or "update".
+	Operation string
+	// Objects identifies the objects affected by the change, e.g. by namespace
+	// and name, as far as they are known.
+	Objects []string
+	// Change is the create, delete or update change as computed by the
+	// resource.
+	Change interface{}
+}
+
+// Plan collects the changes computed by resources during a reconciliation in
+// dry-run mode. The zero value is ready to use and safe for concurrent use.
+type Plan struct {
+	mutex   sync.Mutex
+	changes []Change
+}
+
+// Changes returns the changes added to the plan so far.
+func (p *Plan) Changes() []Change {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	return append([]Change{}, p.changes...)
+}
+
+// NewContext returns a new context.Context that carries value v.
+func NewContext(ctx context.Context, v *Plan) context.Context {
+	if v == nil {
+		return ctx
+	}
+
+	return context.WithValue(ctx, dryRunKey, v)
+}
+
+// FromContext returns the dry-run plan, if any.
+func FromContext(ctx context.Context) (*Plan, bool) {
+	v, ok := ctx.Value(dryRunKey).(*Plan)
+	return v, ok
+}
+
+// IsDryRun checks whether the given context carries a dry-run plan. In this
+// case resources must not apply any changes.
+func IsDryRun(ctx context.Context) bool {
+	_, ok := FromContext(ctx)
+	return ok
+}
+
+// AddChange adds the given change to the dry-run plan carried by the given
+// context. Nothing happens in case the context does not carry a dry-run plan.
+func AddChange(ctx context.Context, c Change) {
+	plan, planExists := FromContext(ctx)
+	if !planExists {
+		return
+	}
+
+	plan.mutex.Lock()
+	defer plan.mutex.Unlock()
+
+	plan.changes = append(plan.changes, c)
+}
@@ -0,0 +1,69 @@
+package dryruncontext
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func Test_Controller_DryRunContext(t *testing.T) {
+	testCases := []struct {
+		Ctx             context.Context
+		ExpectedChanges []Change
+		ExpectedDryRun  bool
+	}{
+		{
+			Ctx:             context.TODO(),
+			ExpectedChanges: nil,
+			ExpectedDryRun:  false,
+		},
+		{
+			Ctx:             NewContext(context.Background(), nil),
+			ExpectedChanges: nil,
+			ExpectedDryRun:  false,
+		},
+		{
+			Ctx: func() context.Context {
+				ctx := NewContext(context.Background(), nil)
+				AddChange(ctx, Change{Resource: "test", Operation: "create"})
+				return ctx
+			}(),
+			ExpectedChanges: nil,
+			ExpectedDryRun:  false,
+		},
+		{
+			Ctx:             NewContext(context.Background(), &Plan{}),
+			ExpectedChanges: []Change{},
+			ExpectedDryRun:  true,
+		},
+		{
+			Ctx: func() context.Context {
+				ctx := NewContext(context.Background(), &Plan{})
+				AddChange(ctx, Change{Resource: "test", Operation: "create"})
+				AddChange(ctx, Change{Resource: "test", Operation: "delete"})
+				return ctx
+			}(),
+			ExpectedChanges: []Change{
+				{Resource: "test", Operation: "create"},
+				{Resource: "test", Operation: "delete"},
+			},
+			ExpectedDryRun: true,
+		},
+	}
+
+	for i, tc := range testCases {
+		dryRun := IsDryRun(tc.Ctx)
+		if dryRun != tc.ExpectedDryRun {
+			t.Fatalf("test %d expected %t got %t", i, tc.ExpectedDryRun, dryRun)
+		}
+
+		var changes []Change
+		plan, ok := FromContext(tc.Ctx)
+		if ok {
+			changes = plan.Changes()
+		}
+		if !reflect.DeepEqual(changes, tc.ExpectedChanges) {
+			t.Fatalf("test %d expected %#v got %#v", i, tc.ExpectedChanges, changes)
+		}
+	}
+}
@@ -4,6 +4,8 @@ package finalizerskeptcontext
 
 import (
 	"context"
+
+	"github.com/giantswarm/operatorkit/v7/pkg/controller/context/internal/signal"
 )
 
 // key is an unexported type for keys defined in this package. This prevents
@@ -21,13 +23,17 @@ func NewContext(ctx context.Context, v chan struct{}) context.Context {
 		return ctx
 	}
 
-	return context.WithValue(ctx, keptKey, v)
+	return context.WithValue(ctx, keptKey, signal.New(v))
 }
 
 // FromContext returns the kept channel, if any.
 func FromContext(ctx context.Context) (chan struct{}, bool) {
-	v, ok := ctx.Value(keptKey).(chan struct{})
-	return v, ok
+	v, ok := ctx.Value(keptKey).(*signal.Signal)
+	if !ok {
+		return nil, false
+	}
+
+	return v.Channel(), true
 }
 
 // IsKept checks whether the given context obtains information about the kept
@@ -64,8 +70,10 @@ func IsKept(ctx context.Context) bool {
 //	finalizerskeptcontext.SetKept(ctx)
 //	resourcecanceledcontext.SetCanceled(ctx)
 func SetKept(ctx context.Context) {
-	kept, keptExists := FromContext(ctx)
-	if keptExists && !IsKept(ctx) {
-		close(kept)
+	v, ok := ctx.Value(keptKey).(*signal.Signal)
+	if !ok {
+		return
 	}
+
+	v.Set()
 }
@@ -0,0 +1,45 @@
+// Package signal implements the close once signals carried in context.Context
+// by the control flow primitives of the controller, e.g. resource cancelation.
+package signal
+
+import "sync"
+
+// Signal wraps a channel which is closed in order to signal something. Each
+// reconciliation creates its own signals, which is why the channel is guarded
+// by a sync.Once of its own. Set can therefore be called concurrently, e.g. by
+// resources executed in parallel.
+type Signal struct {
+	ch   chan struct{}
+	once sync.Once
+}
+
+// New returns a new Signal closing the given channel once it is set.
+func New(ch chan struct{}) *Signal {
+	return &Signal{
+		ch: ch,
+	}
+}
+
+// Channel returns the channel closed once the signal is set.
+func (s *Signal) Channel() chan struct{} {
+	return s.ch
+}
+
+// IsSet returns whether the signal is set.
+func (s *Signal) IsSet() bool {
+	select {
+	case <-s.ch:
+		return true
+	default:
+		return false
+	}
+}
+
+// Set sets the signal by closing its channel, unless it is closed already.
+func (s *Signal) Set() {
+	s.once.Do(func() {
+		if !s.IsSet() {
+			close(s.ch)
+		}
+	})
+}
@@ -0,0 +1,74 @@
+package signal
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func Test_Signal(t *testing.T) {
+	testCases := []struct {
+		name          string
+		signal        func() *Signal
+		expectedIsSet bool
+	}{
+		{
+			name: "case 0: new signal is not set",
+			signal: func() *Signal {
+				return New(make(chan struct{}))
+			},
+			expectedIsSet: false,
+		},
+		{
+			name: "case 1: signal set multiple times is set",
+			signal: func() *Signal {
+				s := New(make(chan struct{}))
+				s.Set()
+				s.Set()
+				return s
+			},
+			expectedIsSet: true,
+		},
+		{
+			name: "case 2: signal with channel closed elsewhere is set",
+			signal: func() *Signal {
+				ch := make(chan struct{})
+				close(ch)
+				s := New(ch)
+				s.Set()
+				return s
+			},
+			expectedIsSet: true,
+		},
+		{
+			name: "case 3: signal set concurrently is set",
+			signal: func() *Signal {
+				s := New(make(chan struct{}))
+
+				var wg sync.WaitGroup
+				for i := 0; i < 10; i++ {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						s.Set()
+					}()
+				}
+				wg.Wait()
+
+				return s
+			},
+			expectedIsSet: true,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			isSet := tc.signal().IsSet()
+			if isSet != tc.expectedIsSet {
+				t.Fatalf("expected %t got %t", tc.expectedIsSet, isSet)
+			}
+		})
+	}
+}
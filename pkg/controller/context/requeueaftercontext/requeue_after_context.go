@@ -0,0 +1,77 @@
+// Package requeueaftercontext stores and accesses the requeue after in
+// context.Context.
+package requeueaftercontext
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// key is an unexported type for keys defined in this package. This prevents
+// collisions with keys defined in other packages.
+type key string
+
+// requeueAfterKey is the key for requeue after values in context.Context.
+// Clients use requeueaftercontext.NewContext and
+// requeueaftercontext.FromContext instead of using this key directly.
+var requeueAfterKey key = "requeueafter"
+
+// RequeueAfter collects the delays requested by resources after which the
+// reconciled runtime object should be reconciled again. Only the shortest
+// delay is kept. The zero value is ready to use and safe for concurrent use.
+type RequeueAfter struct {
+	mutex    sync.Mutex
+	duration time.Duration
+}
+
+// NewContext returns a new context.Context that carries value v.
+func NewContext(ctx context.Context, v *RequeueAfter) context.Context {
+	if v == nil {
+		return ctx
+	}
+
+	return context.WithValue(ctx, requeueAfterKey, v)
+}
+
+// FromContext returns the requeue after value, if any.
+func FromContext(ctx context.Context) (*RequeueAfter, bool) {
+	v, ok := ctx.Value(requeueAfterKey).(*RequeueAfter)
+	return v, ok
+}
+
+// GetRequeueAfter returns the shortest delay requested via SetRequeueAfter, if
+// any.
+func GetRequeueAfter(ctx context.Context) (time.Duration, bool) {
+	requeueAfter, requeueAfterExists := FromContext(ctx)
+	if !requeueAfterExists {
+		return 0, false
+	}
+
+	requeueAfter.mutex.Lock()
+	defer requeueAfter.mutex.Unlock()
+
+	return requeueAfter.duration, requeueAfter.duration > 0
+}
+
+// SetRequeueAfter requests the reconciled runtime object to be reconciled
+// again after the given delay, regardless of the configured resync period. In
+// case multiple delays are requested within the same reconciliation, the
+// shortest one wins. Delays which are not positive are ignored.
+func SetRequeueAfter(ctx context.Context, d time.Duration) {
+	if d <= 0 {
+		return
+	}
+
+	requeueAfter, requeueAfterExists := FromContext(ctx)
+	if !requeueAfterExists {
+		return
+	}
+
+	requeueAfter.mutex.Lock()
+	defer requeueAfter.mutex.Unlock()
+
+	if requeueAfter.duration == 0 || d < requeueAfter.duration {
+		requeueAfter.duration = d
+	}
+}
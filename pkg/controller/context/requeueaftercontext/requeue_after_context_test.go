@@ -0,0 +1,80 @@
+package requeueaftercontext
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func Test_Controller_RequeueAfterContext(t *testing.T) {
+	testCases := []struct {
+		Ctx                  context.Context
+		ExpectedRequeueAfter time.Duration
+		ExpectedOK           bool
+	}{
+		{
+			Ctx:                  context.TODO(),
+			ExpectedRequeueAfter: 0,
+			ExpectedOK:           false,
+		},
+		{
+			Ctx:                  NewContext(context.Background(), nil),
+			ExpectedRequeueAfter: 0,
+			ExpectedOK:           false,
+		},
+		{
+			Ctx:                  NewContext(context.Background(), &RequeueAfter{}),
+			ExpectedRequeueAfter: 0,
+			ExpectedOK:           false,
+		},
+		{
+			Ctx: func() context.Context {
+				ctx := NewContext(context.Background(), nil)
+				SetRequeueAfter(ctx, time.Second)
+				return ctx
+			}(),
+			ExpectedRequeueAfter: 0,
+			ExpectedOK:           false,
+		},
+		{
+			Ctx: func() context.Context {
+				ctx := NewContext(context.Background(), &RequeueAfter{})
+				SetRequeueAfter(ctx, time.Second)
+				return ctx
+			}(),
+			ExpectedRequeueAfter: time.Second,
+			ExpectedOK:           true,
+		},
+		{
+			Ctx: func() context.Context {
+				ctx := NewContext(context.Background(), &RequeueAfter{})
+				SetRequeueAfter(ctx, time.Minute)
+				SetRequeueAfter(ctx, time.Second)
+				SetRequeueAfter(ctx, time.Hour)
+				return ctx
+			}(),
+			ExpectedRequeueAfter: time.Second,
+			ExpectedOK:           true,
+		},
+		{
+			Ctx: func() context.Context {
+				ctx := NewContext(context.Background(), &RequeueAfter{})
+				SetRequeueAfter(ctx, 0)
+				SetRequeueAfter(ctx, -time.Second)
+				return ctx
+			}(),
+			ExpectedRequeueAfter: 0,
+			ExpectedOK:           false,
+		},
+	}
+
+	for i, tc := range testCases {
+		requeueAfter, ok := GetRequeueAfter(tc.Ctx)
+		if ok != tc.ExpectedOK {
+			t.Fatal("test", i+1, "expected", tc.ExpectedOK, "got", ok)
+		}
+		if requeueAfter != tc.ExpectedRequeueAfter {
+			t.Fatal("test", i+1, "expected", tc.ExpectedRequeueAfter, "got", requeueAfter)
+		}
+	}
+}
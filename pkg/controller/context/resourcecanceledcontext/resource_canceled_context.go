@@ -4,6 +4,8 @@ package resourcecanceledcontext
 
 import (
 	"context"
+
+	"github.com/giantswarm/operatorkit/v7/pkg/controller/context/internal/signal"
 )
 
 // key is an unexported type for keys defined in this package. This prevents
@@ -21,13 +23,17 @@ func NewContext(ctx context.Context, v chan struct{}) context.Context {
 		return ctx
 	}
 
-	return context.WithValue(ctx, canceledKey, v)
+	return context.WithValue(ctx, canceledKey, signal.New(v))
 }
 
 // FromContext returns the canceled channel, if any.
 func FromContext(ctx context.Context) (chan struct{}, bool) {
-	v, ok := ctx.Value(canceledKey).(chan struct{})
-	return v, ok
+	v, ok := ctx.Value(canceledKey).(*signal.Signal)
+	if !ok {
+		return nil, false
+	}
+
+	return v.Channel(), true
 }
 
 // IsCanceled checks whether the given context obtains information about the
@@ -54,8 +60,10 @@ func IsCanceled(ctx context.Context) bool {
 
 // SetCanceled is a safe way to signal cancelation.
 func SetCanceled(ctx context.Context) {
-	canceled, canceledExists := FromContext(ctx)
-	if canceledExists && !IsCanceled(ctx) {
-		close(canceled)
+	v, ok := ctx.Value(canceledKey).(*signal.Signal)
+	if !ok {
+		return
 	}
+
+	v.Set()
 }
@@ -2,6 +2,7 @@ package resourcecanceledcontext
 
 import (
 	"context"
+	"sync"
 	"testing"
 )
 
@@ -48,6 +49,24 @@ func Test_Controller_ResourceCanceledContext(t *testing.T) {
 			}(),
 			ExpectedIsCanceled: true,
 		},
+		{
+			Ctx: func() context.Context {
+				ctx := NewContext(context.Background(), make(chan struct{}))
+
+				var wg sync.WaitGroup
+				for i := 0; i < 10; i++ {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						SetCanceled(ctx)
+					}()
+				}
+				wg.Wait()
+
+				return ctx
+			}(),
+			ExpectedIsCanceled: true,
+		},
 	}
 
 	for i, tc := range testCases {
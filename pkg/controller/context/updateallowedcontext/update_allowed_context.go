@@ -4,6 +4,8 @@ package updateallowedcontext
 
 import (
 	"context"
+
+	"github.com/giantswarm/operatorkit/v7/pkg/controller/context/internal/signal"
 )
 
 // key is an unexported type for keys defined in this package. This prevents
@@ -21,13 +23,17 @@ func NewContext(ctx context.Context, v chan struct{}) context.Context {
 		return ctx
 	}
 
-	return context.WithValue(ctx, updateAllowedKey, v)
+	return context.WithValue(ctx, updateAllowedKey, signal.New(v))
 }
 
 // FromContext returns the update allowed channel, if any.
 func FromContext(ctx context.Context) (chan struct{}, bool) {
-	v, ok := ctx.Value(updateAllowedKey).(chan struct{})
-	return v, ok
+	v, ok := ctx.Value(updateAllowedKey).(*signal.Signal)
+	if !ok {
+		return nil, false
+	}
+
+	return v.Channel(), true
 }
 
 // IsUpdateAllowed checks whether the given context obtains information about
@@ -55,8 +61,10 @@ func IsUpdateAllowed(ctx context.Context) bool {
 
 // SetUpdateAllowed is a safe way to signal updates are allowed.
 func SetUpdateAllowed(ctx context.Context) {
-	updateAllowed, updateAllowedExists := FromContext(ctx)
-	if updateAllowedExists && !IsUpdateAllowed(ctx) {
-		close(updateAllowed)
+	v, ok := ctx.Value(updateAllowedKey).(*signal.Signal)
+	if !ok {
+		return
 	}
+
+	v.Set()
 }
@@ -22,7 +22,8 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
-	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
@@ -35,19 +36,28 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/giantswarm/operatorkit/v7/pkg/conditions"
 	"github.com/giantswarm/operatorkit/v7/pkg/controller/collector"
 	"github.com/giantswarm/operatorkit/v7/pkg/controller/context/cachekeycontext"
+	"github.com/giantswarm/operatorkit/v7/pkg/controller/context/controllernamecontext"
+	"github.com/giantswarm/operatorkit/v7/pkg/controller/context/dryruncontext"
 	"github.com/giantswarm/operatorkit/v7/pkg/controller/context/finalizerskeptcontext"
 	"github.com/giantswarm/operatorkit/v7/pkg/controller/context/reconciliationcanceledcontext"
+	"github.com/giantswarm/operatorkit/v7/pkg/controller/context/requeueaftercontext"
 	"github.com/giantswarm/operatorkit/v7/pkg/controller/context/resourcecanceledcontext"
 	"github.com/giantswarm/operatorkit/v7/pkg/controller/context/updateallowedcontext"
+	"github.com/giantswarm/operatorkit/v7/pkg/controller/internal/name"
 	"github.com/giantswarm/operatorkit/v7/pkg/controller/internal/recorder"
 	"github.com/giantswarm/operatorkit/v7/pkg/controller/internal/sentry"
+	"github.com/giantswarm/operatorkit/v7/pkg/handler"
+	"github.com/giantswarm/operatorkit/v7/pkg/handler/resourcehandler"
 	"github.com/giantswarm/operatorkit/v7/pkg/resource"
 )
 
 const (
-	DefaultResyncPeriod = 5 * time.Minute
+	DefaultGracefulShutdownTimeout = 30 * time.Second
+	DefaultMaxConcurrentReconciles = 1
+	DefaultResyncPeriod            = 5 * time.Minute
 )
 
 const (
@@ -71,6 +81,21 @@ var (
 )
 
 type Config struct {
+	// GracefulShutdownTimeout is the duration the controller waits for
+	// in-flight reconciliations to finish once it is stopped. No new
+	// reconciliations are started during that time. Reconciliations still
+	// running after the timeout get their context canceled. Defaults to
+	// DefaultGracefulShutdownTimeout.
+	GracefulShutdownTimeout time.Duration
+	// HandleSignals causes the controller to be stopped once SIGINT or SIGTERM
+	// is received. A second signal exits the process. Signal handling is
+	// process wide and therefore disabled by default. Operators should rather
+	// cancel the context given to Boot.
+	HandleSignals bool
+	// Handlers is the list of controller handlers being executed on runtime
+	// object reconciliation. Handlers are executed in given order after the
+	// configured Resources, within the same chain.
+	Handlers []handler.Interface
 	// InitCtx is deprecated and should not be used anymore.
 	InitCtx func(ctx context.Context, obj interface{}) (context.Context, error)
 	// K8sClient is the client collection used to setup and manage certain
@@ -78,7 +103,12 @@ type Config struct {
 	// objects. It therefore must be properly configured using the AddToScheme
 	// option. The REST Client is used to patch finalizers on runtime objects.
 	K8sClient k8sclient.Interface
-	Logger    micrologger.Logger
+	// LeaderElectionChangeFunc is optionally called whenever the controller
+	// acquires or loses leadership. The controller is considered to be leader
+	// as soon as it starts reconciling runtime objects. Without leader election
+	// being enabled this is always the case once the controller booted.
+	LeaderElectionChangeFunc func(ctx context.Context, leader bool)
+	Logger                   micrologger.Logger
 	// NewRuntimeObjectFunc returns a new initialized pointer of a type
 	// implementing the runtime object interface. The object returned is used with
 	// the controller-runtime client to fetch the latest version of the object
@@ -90,13 +120,65 @@ type Config struct {
 	//     }
 	//
 	NewRuntimeObjectFunc func() client.Object
-	Pause                map[string]string
+	// Owns is the list of runtime object types created by the resources and
+	// owned by the reconciled runtime objects via controller owner references.
+	// Changes of these runtime objects trigger the reconciliation of their
+	// owners. Entries must be initialized pointers, e.g. new(corev1.ConfigMap).
+	Owns  []client.Object
+	Pause map[string]string
+	// RateLimiter is the rate limiter used to requeue runtime objects which
+	// failed to reconcile when RequeueOnError is enabled. Defaults to the
+	// controller-runtime default, which is a per-object exponential backoff
+	// combined with an overall token bucket.
+	RateLimiter workqueue.TypedRateLimiter[reconcile.Request]
 	// Resources is the list of controller resources being executed on runtime
-	// object reconciliation. Resources are executed in given order.
+	// object reconciliation. Resources are executed in given order before the
+	// configured Handlers. Either Resources or Handlers must be configured.
 	Resources []resource.Interface
 	// Selector is used to filter objects before passing them to the controller.
+	// It only applies to the reconciled runtime objects, not to the runtime
+	// objects configured in Owns and Watches.
 	Selector labels.Selector
-
+	// Watches is the list of secondary runtime object types whose changes
+	// trigger the reconciliation of the primary runtime objects returned by
+	// the configured mapping function.
+	Watches []Watch
+
+	// DryRun enables the dry-run mode. In dry-run mode CRUD resources compute
+	// their patches but do not apply them, unless they implement
+	// crud.DryRunner. The computed changes are logged, counted and kept per
+	// runtime object, see Controller.Plan. Finalizers and status patches are
+	// not written either. Handlers and basic resources are still executed and
+	// have to check dryruncontext.IsDryRun on their own.
+	DryRun bool
+	// LeaderElection enables leader election between all replicas running the
+	// same controller. Only the elected replica reconciles runtime objects.
+	// Metrics and collectors are served by all replicas.
+	LeaderElection bool
+	// LeaderElectionID is the name of the Lease object used for leader
+	// election. Defaults to Name.
+	LeaderElectionID string
+	// LeaderElectionNamespace is the namespace the Lease object used for leader
+	// election is created in. Defaults to Namespace. If both are empty the
+	// namespace the operator runs in is used.
+	LeaderElectionNamespace string
+	// LeaderElectionLeaseDuration is the duration standby replicas wait before
+	// trying to acquire leadership of a lease which is not renewed anymore.
+	// Defaults to the controller-runtime default.
+	LeaderElectionLeaseDuration time.Duration
+	// LeaderElectionReleaseOnCancel defines if the leader steps down
+	// voluntarily when the controller is stopped. This speeds up leader
+	// transitions but requires the operator to exit right after the controller
+	// stopped.
+	LeaderElectionReleaseOnCancel bool
+	// LeaderElectionRenewDeadline is the duration the leader retries refreshing
+	// leadership before giving up. Must be smaller than
+	// LeaderElectionLeaseDuration. Defaults to the controller-runtime default.
+	LeaderElectionRenewDeadline time.Duration
+	// MaxConcurrentReconciles is the maximum number of runtime objects being
+	// reconciled in parallel. The same runtime object is never reconciled
+	// concurrently. Defaults to DefaultMaxConcurrentReconciles.
+	MaxConcurrentReconciles int
 	// Name is the name which the controller uses on finalizers for resources.
 	// The name used should be unique in the kubernetes cluster, to ensure that
 	// two operators which handle the same resource add two distinct finalizers.
@@ -104,6 +186,32 @@ type Config struct {
 	// Namespace is where the controller would reconcile the runtime objects.
 	// Empty string means all namespaces.
 	Namespace string
+	// ReadyCondition enables writing the outcome of each reconciliation to the
+	// status subresource of the reconciled runtime object. The generation of
+	// the runtime object is written as observed generation, and the Ready
+	// condition is set to true on success. On failure it is set to false with
+	// the microerror kind of the error as reason and the name of the failing
+	// resource or handler in the message. Canceled reconciliations do not
+	// modify the status. The runtime objects returned by NewRuntimeObjectFunc
+	// must implement StatusObject. The status is not written in dry-run mode.
+	ReadyCondition bool
+	// ReconcileTimeout is the maximum duration of a single reconciliation of a
+	// runtime object. Once exceeded, the context of the reconciliation is
+	// canceled and the resource being executed is considered timed out. Timed
+	// out resources are counted, emitted as Kubernetes event and the runtime
+	// object is requeued using RateLimiter. Resources and handlers have to
+	// honour the cancelation of their context. Defaults to no timeout.
+	ReconcileTimeout time.Duration
+	// RequeueOnError causes runtime objects which failed to reconcile to be
+	// requeued using RateLimiter, which applies a per-object exponential
+	// backoff by default. When disabled, reconciliation errors are only logged
+	// and emitted as events, and the runtime object is reconciled again with
+	// the next resync.
+	RequeueOnError bool
+	// ResourceTimeout is the maximum duration of a single resource or handler
+	// execution. Timed out resources are handled the same way as when
+	// exceeding ReconcileTimeout. Defaults to no timeout.
+	ResourceTimeout time.Duration
 	// ResyncPeriod is the duration after which a complete sync with all known
 	// runtime objects the controller watches is performed. Defaults to
 	// DefaultResyncPeriod.
@@ -117,28 +225,57 @@ type Config struct {
 }
 
 type Controller struct {
-	event                recorder.Interface
-	initCtx              func(ctx context.Context, obj interface{}) (context.Context, error)
-	k8sClient            k8sclient.Interface
-	logger               micrologger.Logger
-	newRuntimeObjectFunc func() client.Object
-	pause                map[string]string
-	resources            []resource.Interface
-	selector             labels.Selector
+	event                    recorder.Interface
+	handlers                 []handler.Interface
+	initCtx                  func(ctx context.Context, obj interface{}) (context.Context, error)
+	k8sClient                k8sclient.Interface
+	leaderElectionChangeFunc func(ctx context.Context, leader bool)
+	logger                   micrologger.Logger
+	newRuntimeObjectFunc     func() client.Object
+	owns                     []client.Object
+	pause                    map[string]string
+	rateLimiter              workqueue.TypedRateLimiter[reconcile.Request]
+	selector                 labels.Selector
+	watches                  []Watch
 
 	backOffFactory         func() backoff.Interface
+	backOffObjects         *keySet
+	failingObjects         *keySet
 	bootOnce               sync.Once
 	booted                 chan struct{}
+	stopMutex              sync.Mutex
 	stopOnce               sync.Once
 	stop                   func()
+	stopped                chan struct{}
 	collector              *collector.Set
+	drainer                *drainer
 	loop                   int64
+	objectLock             *keyLock
+	plans                  *planStore
 	removedFinalizersCache *stringCache
 	sentry                 sentry.Interface
-
-	name         string
-	namespace    string
-	resyncPeriod time.Duration
+	statusPatcher          *conditions.Patcher
+
+	runtimeErrorOnce        sync.Once
+	unregisterRuntimeErrors func()
+
+	dryRun                        bool
+	gracefulShutdownTimeout       time.Duration
+	handleSignals                 bool
+	leaderElection                bool
+	leaderElectionID              string
+	leaderElectionNamespace       string
+	leaderElectionLeaseDuration   time.Duration
+	leaderElectionReleaseOnCancel bool
+	leaderElectionRenewDeadline   time.Duration
+	maxConcurrentReconciles       int
+	name                          string
+	namespace                     string
+	readyCondition                bool
+	reconcileTimeout              time.Duration
+	requeueOnError                bool
+	resourceTimeout               time.Duration
+	resyncPeriod                  time.Duration
 }
 
 // New creates a new configured operator controller.
@@ -158,6 +295,11 @@ func New(config Config) (*Controller, error) {
 		return nil, microerror.Maskf(invalidConfigError, "%T.NewRuntimeObjectFunc must not be empty", config)
 	}
 
+	for i, o := range config.Owns {
+		if o == nil {
+			return nil, microerror.Maskf(invalidConfigError, "%T.Owns[%d] must not be empty", config, i)
+		}
+	}
 	{
 		if config.Pause == nil {
 			config.Pause = map[string]string{}
@@ -166,22 +308,98 @@ func New(config Config) (*Controller, error) {
 			config.Pause[k] = v
 		}
 	}
-	if len(config.Resources) == 0 {
-		return nil, microerror.Maskf(invalidConfigError, "%T.Resources must not be empty", config)
+	if len(config.Resources) == 0 && len(config.Handlers) == 0 {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Resources or %T.Handlers must not be empty", config, config)
 	}
 	if config.Selector == nil {
 		config.Selector = labels.Everything()
 	}
+	for i, w := range config.Watches {
+		if w.MapFunc == nil {
+			return nil, microerror.Maskf(invalidConfigError, "%T.Watches[%d].MapFunc must not be empty", config, i)
+		}
+		if w.Object == nil {
+			return nil, microerror.Maskf(invalidConfigError, "%T.Watches[%d].Object must not be empty", config, i)
+		}
+	}
 
+	if config.GracefulShutdownTimeout < 0 {
+		return nil, microerror.Maskf(invalidConfigError, "%T.GracefulShutdownTimeout must not be negative", config)
+	}
+	if config.GracefulShutdownTimeout == 0 {
+		config.GracefulShutdownTimeout = DefaultGracefulShutdownTimeout
+	}
+	if config.LeaderElection {
+		if config.LeaderElectionID == "" {
+			config.LeaderElectionID = config.Name
+		}
+		if config.LeaderElectionNamespace == "" {
+			config.LeaderElectionNamespace = config.Namespace
+		}
+		if config.LeaderElectionLeaseDuration < 0 {
+			return nil, microerror.Maskf(invalidConfigError, "%T.LeaderElectionLeaseDuration must not be negative", config)
+		}
+		if config.LeaderElectionRenewDeadline < 0 {
+			return nil, microerror.Maskf(invalidConfigError, "%T.LeaderElectionRenewDeadline must not be negative", config)
+		}
+		if config.LeaderElectionLeaseDuration != 0 && config.LeaderElectionRenewDeadline >= config.LeaderElectionLeaseDuration {
+			return nil, microerror.Maskf(invalidConfigError, "%T.LeaderElectionRenewDeadline must be smaller than %T.LeaderElectionLeaseDuration", config, config)
+		}
+	}
+	if config.MaxConcurrentReconciles < 0 {
+		return nil, microerror.Maskf(invalidConfigError, "%T.MaxConcurrentReconciles must not be negative", config)
+	}
+	if config.MaxConcurrentReconciles == 0 {
+		config.MaxConcurrentReconciles = DefaultMaxConcurrentReconciles
+	}
 	if config.Name == "" {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Name must not be empty", config)
 	}
+	if config.ReadyCondition {
+		o := config.NewRuntimeObjectFunc()
+		_, ok := o.(StatusObject)
+		if !ok {
+			return nil, microerror.Maskf(invalidConfigError, "%T.NewRuntimeObjectFunc must return %T implementing StatusObject when %T.ReadyCondition is enabled", config, o, config)
+		}
+	}
+	if config.ReconcileTimeout < 0 {
+		return nil, microerror.Maskf(invalidConfigError, "%T.ReconcileTimeout must not be negative", config)
+	}
+	if config.ResourceTimeout < 0 {
+		return nil, microerror.Maskf(invalidConfigError, "%T.ResourceTimeout must not be negative", config)
+	}
 	if config.ResyncPeriod == 0 {
 		config.ResyncPeriod = DefaultResyncPeriod
 	}
 
 	var err error
 
+	// Resources are bridged to handlers so that resources and handlers are
+	// executed within the same chain.
+	var handlers []handler.Interface
+	{
+		for _, r := range config.Resources {
+			c := resourcehandler.Config{
+				Resource: r,
+			}
+
+			h, err := resourcehandler.New(c)
+			if err != nil {
+				return nil, microerror.Mask(err)
+			}
+
+			handlers = append(handlers, h)
+		}
+
+		for i, h := range config.Handlers {
+			if h == nil {
+				return nil, microerror.Maskf(invalidConfigError, "%T.Handlers[%d] must not be empty", config, i)
+			}
+
+			handlers = append(handlers, h)
+		}
+	}
+
 	var collectorSet *collector.Set
 	{
 		c := collector.SetConfig{
@@ -210,6 +428,18 @@ func New(config Config) (*Controller, error) {
 		eventRecorder = recorder.New(c)
 	}
 
+	var statusPatcher *conditions.Patcher
+	if config.ReadyCondition {
+		c := conditions.PatcherConfig{
+			CtrlClient: config.K8sClient.CtrlClient(),
+		}
+
+		statusPatcher, err = conditions.NewPatcher(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
 	var sentryClient sentry.Interface
 	{
 		c := sentry.Config{
@@ -225,40 +455,95 @@ func New(config Config) (*Controller, error) {
 	}
 
 	c := &Controller{
-		event:                eventRecorder,
-		initCtx:              config.InitCtx,
-		k8sClient:            config.K8sClient,
-		logger:               config.Logger,
-		newRuntimeObjectFunc: config.NewRuntimeObjectFunc,
-		pause:                config.Pause,
-		resources:            config.Resources,
-		selector:             config.Selector,
+		event:                    eventRecorder,
+		handlers:                 handlers,
+		initCtx:                  config.InitCtx,
+		k8sClient:                config.K8sClient,
+		leaderElectionChangeFunc: config.LeaderElectionChangeFunc,
+		logger:                   config.Logger,
+		newRuntimeObjectFunc:     config.NewRuntimeObjectFunc,
+		owns:                     config.Owns,
+		pause:                    config.Pause,
+		rateLimiter:              config.RateLimiter,
+		selector:                 config.Selector,
+		watches:                  config.Watches,
 
 		backOffFactory:         func() backoff.Interface { return backoff.NewMaxRetries(7, 1*time.Second) },
+		backOffObjects:         newKeySet(),
+		failingObjects:         newKeySet(),
 		bootOnce:               sync.Once{},
 		booted:                 make(chan struct{}),
+		stopped:                make(chan struct{}),
 		collector:              collectorSet,
+		drainer:                newDrainer(config.GracefulShutdownTimeout),
 		loop:                   -1,
+		objectLock:             newKeyLock(),
+		plans:                  newPlanStore(),
 		removedFinalizersCache: newStringCache(config.ResyncPeriod * 3),
 		sentry:                 sentryClient,
-
-		name:         config.Name,
-		namespace:    config.Namespace,
-		resyncPeriod: config.ResyncPeriod,
+		statusPatcher:          statusPatcher,
+
+		dryRun:                        config.DryRun,
+		gracefulShutdownTimeout:       config.GracefulShutdownTimeout,
+		handleSignals:                 config.HandleSignals,
+		leaderElection:                config.LeaderElection,
+		leaderElectionID:              config.LeaderElectionID,
+		leaderElectionNamespace:       config.LeaderElectionNamespace,
+		leaderElectionLeaseDuration:   config.LeaderElectionLeaseDuration,
+		leaderElectionReleaseOnCancel: config.LeaderElectionReleaseOnCancel,
+		leaderElectionRenewDeadline:   config.LeaderElectionRenewDeadline,
+		maxConcurrentReconciles:       config.MaxConcurrentReconciles,
+		name:                          config.Name,
+		namespace:                     config.Namespace,
+		readyCondition:                config.ReadyCondition,
+		reconcileTimeout:              config.ReconcileTimeout,
+		requeueOnError:                config.RequeueOnError,
+		resourceTimeout:               config.ResourceTimeout,
+		resyncPeriod:                  config.ResyncPeriod,
 	}
 
 	return c, nil
 }
 
+// Boot boots the controller and blocks until the controller is stopped. In
+// case booting fails after several retries, Boot exits the process. Use
+// BootWithError in order to handle boot failures.
 func (c *Controller) Boot(ctx context.Context) {
+	err := c.BootWithError(ctx)
+	if err != nil {
+		os.Exit(1)
+	}
+}
+
+// BootWithError boots the controller and blocks until the controller is
+// stopped, either by canceling ctx or by calling Stop. Booting is retried
+// several times. In case booting keeps failing the last error is returned. The
+// controller can only be booted once. Subsequent calls return nil right away.
+func (c *Controller) BootWithError(ctx context.Context) error {
+	ctx = newLoggerCtx(ctx)
 	ctx = setLoggerCtxValue(ctx, loggerKeyController, c.name)
 
+	var err error
+
 	c.bootOnce.Do(func() {
+		defer close(c.stopped)
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		c.stopMutex.Lock()
+		c.stop = cancel
+		c.stopMutex.Unlock()
+
 		c.logger.Debugf(ctx, "booting controller")
 
 		operation := func() error {
-			err := c.bootWithError(ctx)
-			if err != nil {
+			err := c.boot(ctx)
+			if ctx.Err() != nil {
+				// The controller got stopped. There is no point in retrying
+				// anymore.
+				return nil
+			} else if err != nil {
 				return microerror.Mask(err)
 			}
 
@@ -267,46 +552,102 @@ func (c *Controller) Boot(ctx context.Context) {
 
 		notifier := backoff.NewNotifier(c.logger, ctx)
 
-		err := backoff.RetryNotify(operation, c.backOffFactory(), notifier)
+		err = backoff.RetryNotify(operation, c.backOffFactory(), notifier)
 		if err != nil {
 			c.sentry.Capture(ctx, err)
 			c.logger.Errorf(ctx, err, "stop controller boot retries due to too many errors")
-			os.Exit(1)
+			err = microerror.Mask(err)
+			return
 		}
 
-		c.logger.Debugf(ctx, "booted controller")
+		c.logger.Debugf(ctx, "stopped controller")
 	})
+
+	return err
 }
 
 func (c *Controller) Booted() chan struct{} {
 	return c.booted
 }
 
+// Stop stops the controller and waits until it stopped completely, in case it
+// was booted before.
 func (c *Controller) Stop(ctx context.Context) {
 	c.stopOnce.Do(func() {
 		c.collector.Stop(ctx)
-		if c.stop != nil {
-			c.stop()
+		// Calling the once here prevents registering the controller with the
+		// k8s error handling after it got stopped.
+		c.runtimeErrorOnce.Do(func() {})
+		if c.unregisterRuntimeErrors != nil {
+			c.unregisterRuntimeErrors()
+		}
+
+		c.stopMutex.Lock()
+		stop := c.stop
+		c.stopMutex.Unlock()
+
+		if stop != nil {
+			stop()
+			<-c.stopped
 		}
 	})
 }
 
 // Reconcile implements the reconciler given to the controller-runtime
-// controller. Reconcile never returns any error as we deal with them in
-// operatorkit internally.
+// controller. Reconcile only returns errors when RequeueOnError is enabled so
+// that the runtime object is requeued using the configured rate limiter.
+// Otherwise errors are dealt with in operatorkit internally. Reconcile is safe
+// to be called concurrently. Calls for the same runtime object are serialized.
 func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
-	// Add common keys to the logger context.
+	// The workqueue of the controller-runtime controller already ensures that
+	// the same request is never processed by multiple workers at the same time.
+	// We still lock on the object key here so that the guarantee does not
+	// depend on the queue implementation being used.
+	{
+		key := req.NamespacedName.String()
+
+		c.objectLock.Lock(key)
+		defer c.objectLock.Unlock(key)
+	}
+
+	// The context given by the controller-runtime controller is canceled as soon
+	// as the manager is stopped. In order to let in-flight reconciliations
+	// finish gracefully, we only cancel the reconciliation once the graceful
+	// shutdown timeout is exceeded.
+	{
+		var cancel func()
+		ctx, cancel = context.WithCancel(context.WithoutCancel(ctx))
+		defer cancel()
+
+		stop := context.AfterFunc(c.drainer.Context(), cancel)
+		defer stop()
+	}
+
+	// Add common keys to the logger context. Each reconciliation gets its own
+	// copy of the logger meta so that concurrent reconciliations do not
+	// overwrite each other's values.
 	{
 		loop := strconv.FormatInt(atomic.AddInt64(&c.loop, 1), 10)
 
 		ctx = cachekeycontext.NewContext(ctx, fmt.Sprintf("%s-%s", c.name, loop))
+		ctx = controllernamecontext.NewContext(ctx, c.name)
 		ctx = finalizerskeptcontext.NewContext(ctx, make(chan struct{}))
+		ctx = requeueaftercontext.NewContext(ctx, &requeueaftercontext.RequeueAfter{})
 		ctx = updateallowedcontext.NewContext(ctx, make(chan struct{}))
 
+		ctx = newLoggerCtx(ctx)
 		ctx = setLoggerCtxValue(ctx, loggerKeyLoop, loop)
 		ctx = setLoggerCtxValue(ctx, loggerKeyController, c.name)
 	}
 
+	// In dry-run mode the changes computed by the resources are collected in a
+	// plan, which replaces the plan of the previous reconciliation.
+	var plan *dryruncontext.Plan
+	if c.dryRun {
+		plan = &dryruncontext.Plan{}
+		ctx = dryruncontext.NewContext(ctx, plan)
+	}
+
 	obj := c.newRuntimeObjectFunc()
 	err := c.k8sClient.CtrlClient().Get(ctx, req.NamespacedName, obj)
 	if errors.IsNotFound(err) {
@@ -316,66 +657,141 @@ func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		// object and it got purged from the controller-runtime cache. We do not
 		// need to log these errors and just stop processing here in a more graceful
 		// way.
+		c.setBackOff(req, false)
+		c.deleteObjectMetrics(req)
+		c.plans.Delete(req.NamespacedName.String())
 		return reconcile.Result{}, nil
 	} else if err != nil {
 		return reconcile.Result{}, microerror.Mask(err)
 	}
 
+	// Owned and watched runtime objects are mapped to the reconciled runtime
+	// objects regardless of their labels. The selector is therefore checked
+	// against the fetched runtime object, which may also have changed its
+	// labels since it got enqueued.
+	if !c.selector.Matches(labels.Set(obj.GetLabels())) {
+		c.logger.Debugf(ctx, "skipping object not matching selector %#q", c.selector.String())
+		c.setBackOff(req, false)
+		c.deleteObjectMetrics(req)
+		c.plans.Delete(req.NamespacedName.String())
+		return reconcile.Result{}, nil
+	}
+
 	res, err := c.reconcile(ctx, req, obj)
+
+	if plan != nil {
+		c.plans.Set(req.NamespacedName.String(), plan.Changes())
+	}
+
+	// Resources may request the runtime object to be reconciled again after
+	// some delay, e.g. when waiting for external systems. The shortest
+	// requested delay wins. This also applies to failed reconciliations.
+	if d, ok := requeueaftercontext.GetRequeueAfter(ctx); ok {
+		if res.RequeueAfter == 0 || d < res.RequeueAfter {
+			res.RequeueAfter = d
+		}
+	}
+
 	if err != nil {
 		// Microerror creates an error event on the object when kind and description is set.
 		c.event.Emit(ctx, obj, err)
 		reconcileErrors.WithLabelValues(c.name).Inc()
+		c.setFailing(req, true)
 		c.sentry.Capture(ctx, err)
 		c.logger.Errorf(ctx, err, "failed to reconcile")
-		return reconcile.Result{}, nil
+
+		// When requeueing on errors, the error is returned to the
+		// controller-runtime controller which requeues the runtime object
+		// using the rate limiter. Requested delays are ignored in this case.
+		if c.requeueOnError || IsTimeout(err) {
+			c.setBackOff(req, true)
+			return reconcile.Result{}, microerror.Mask(err)
+		}
+
+		return reconcile.Result{RequeueAfter: res.RequeueAfter}, nil
+	}
+
+	c.setBackOff(req, false)
+	c.setFailing(req, false)
+
+	if res.RequeueAfter != 0 {
+		c.logger.Debugf(ctx, "requeueing object after %s", res.RequeueAfter)
 	}
 
-	lastReconciledGauge.WithLabelValues(
-		c.name,
-	).SetToCurrentTime()
+	lastReconciledGauge.WithLabelValues(c.name, req.NamespacedName.String()).SetToCurrentTime()
 
 	return res, nil
 }
 
-func (c *Controller) bootWithError(ctx context.Context) error {
-	var err error
+// Plan returns the changes computed during the latest reconciliation of the
+// runtime object with the given namespaced name in dry-run mode. It returns
+// false in case the runtime object was not reconciled in dry-run mode yet.
+func (c *Controller) Plan(key types.NamespacedName) ([]dryruncontext.Change, bool) {
+	changes, ok := c.plans.Get(key.String())
+	return changes, ok
+}
 
-	// Boot the collector.
-	err = c.collector.Boot(ctx)
+// SetupWithManager registers the controller with the given manager instead of
+// booting a dedicated manager via Boot or BootWithError. That way multiple
+// controllers share the same manager, informer cache and watches. The manager
+// is owned by the caller, who is responsible for starting it. Note that the
+// cache and leader election options of the manager apply in this case, which
+// is why Namespace, ResyncPeriod and the leader election settings of the
+// controller Config are ignored.
+func (c *Controller) SetupWithManager(mgr manager.Manager) error {
+	ctx := newLoggerCtx(context.Background())
+	ctx = setLoggerCtxValue(ctx, loggerKeyController, c.name)
+
+	err := c.setup(ctx, mgr)
 	if err != nil {
 		return microerror.Mask(err)
 	}
 
-	go func() {
-		for {
-			resetWait := c.resyncPeriod * 4
-			time.Sleep(resetWait)
-			reconcileErrors.WithLabelValues(c.name).Set(0)
+	return nil
+}
+
+// applyResponse applies the outcome of a handler execution for the given
+// runtime object.
+func (c *Controller) applyResponse(ctx context.Context, obj interface{}, res *handler.Response) error {
+	if res == nil {
+		return nil
+	}
+
+	if res.KeepFinalizers {
+		finalizerskeptcontext.SetKept(ctx)
+	}
+
+	if res.RequeueAfter != 0 {
+		requeueaftercontext.SetRequeueAfter(ctx, res.RequeueAfter)
+	}
+
+	if res.StatusPatch != nil {
+		o, ok := obj.(client.Object)
+		if !ok {
+			return microerror.Maskf(wrongTypeError, "expected '%T', got '%T'", o, obj)
 		}
-	}()
 
-	// We overwrite the k8s error handlers so they do not intercept our log
-	// streams. The format is way easier to parse for us that way. Here we also
-	// emit metrics for the occurred errors to ensure we create more awareness of
-	// anything going wrong in our operators.
-	{
-		utilruntime.ErrorHandlers = []utilruntime.ErrorHandler{
-			func(ctx context.Context, err error, _ string, _ ...interface{}) {
-				// When we see a port forwarding error we ignore it because we cannot do
-				// anything about it. Errors like we check here would have to be dealt
-				// with in the third party tools we use. The port forwarding in general
-				// is broken by design which will go away with Helm 3, soon TM.
-				if IsPortforward(err) {
-					return
-				}
-
-				reconcileErrors.WithLabelValues(c.name).Inc()
-				c.logger.Errorf(ctx, err, " caught third party runtime error")
-			},
+		if dryruncontext.IsDryRun(ctx) {
+			c.logger.Debugf(ctx, "not patching status in dry-run mode")
+			return nil
+		}
+
+		c.logger.Debugf(ctx, "patching status")
+
+		err := c.k8sClient.CtrlClient().Status().Patch(ctx, o, res.StatusPatch)
+		if err != nil {
+			return microerror.Mask(err)
 		}
+
+		c.logger.Debugf(ctx, "patched status")
 	}
 
+	return nil
+}
+
+func (c *Controller) boot(ctx context.Context) error {
+	var err error
+
 	var mgr manager.Manager
 	{
 		var cacheOptions = cache.Options{
@@ -391,12 +807,23 @@ func (c *Controller) bootWithError(ctx context.Context) error {
 			Controller: config.Controller{
 				SkipNameValidation: ptr.To(true),
 			},
+			GracefulShutdownTimeout:       to.DurationP(c.gracefulShutdownTimeout),
+			LeaderElection:                c.leaderElection,
+			LeaderElectionID:              c.leaderElectionID,
+			LeaderElectionNamespace:       c.leaderElectionNamespace,
+			LeaderElectionReleaseOnCancel: c.leaderElectionReleaseOnCancel,
 			Metrics: server.Options{
 				// MetricsBindAddress is set to 0 in order to disable it. We do this
 				// ourselves.
 				BindAddress: DisableMetricsServing,
 			},
 		}
+		if c.leaderElectionLeaseDuration != 0 {
+			o.LeaseDuration = to.DurationP(c.leaderElectionLeaseDuration)
+		}
+		if c.leaderElectionRenewDeadline != 0 {
+			o.RenewDeadline = to.DurationP(c.leaderElectionRenewDeadline)
+		}
 
 		mgr, err = manager.New(c.k8sClient.RESTConfig(), o)
 		if err != nil {
@@ -404,43 +831,22 @@ func (c *Controller) bootWithError(ctx context.Context) error {
 		}
 	}
 
-	{
-		// We build our controller and set up its reconciliation.
-		// We use the Complete() method instead of Build() because we don't
-		// need the controller instance.
-		err = builder.
-			ControllerManagedBy(mgr).
-			For(c.newRuntimeObjectFunc()).
-			WithOptions(controller.Options{
-				MaxConcurrentReconciles: 1,
-			}).
-			WithEventFilter(predicate.Funcs{
-				CreateFunc:  func(e event.CreateEvent) bool { return c.selector.Matches(labels.Set(e.Object.GetLabels())) },
-				DeleteFunc:  func(e event.DeleteEvent) bool { return c.selector.Matches(labels.Set(e.Object.GetLabels())) },
-				UpdateFunc:  func(e event.UpdateEvent) bool { return c.selector.Matches(labels.Set(e.ObjectNew.GetLabels())) },
-				GenericFunc: func(e event.GenericEvent) bool { return c.selector.Matches(labels.Set(e.Object.GetLabels())) },
-			}).
-			Complete(c)
-		if err != nil {
-			return microerror.Mask(err)
-		}
+	err = c.setup(ctx, mgr)
+	if err != nil {
+		return microerror.Mask(err)
+	}
 
-		// We put the controller into a booted state by closing its booted
-		// channel once so users know when to go ahead.
-		select {
-		case <-c.booted:
-		default:
-			close(c.booted)
+	{
+		// Signal handling is process wide, which is why it is opt-in. By default
+		// the controller is only stopped by canceling the context given to Boot
+		// or by calling Stop.
+		if c.handleSignals {
+			release := setupSignalHandler(func() {
+				c.Stop(ctx)
+			})
+			defer release()
 		}
 
-		ctx, cancel := context.WithCancel(ctx)
-		c.stop = cancel
-
-		// handle ctrl+c
-		setupSignalHandler(func() {
-			c.Stop(ctx)
-		})
-
 		// mgr.Start() blocks the boot process until it ends gracefully or fails.
 		err = mgr.Start(ctx)
 		if err != nil {
@@ -459,21 +865,27 @@ func (c *Controller) deleteFunc(ctx context.Context, obj interface{}) error {
 		return microerror.Mask(err)
 	}
 	if !hasFinalizer {
+		// Without finalizer there is nothing to reconcile, which is why the
+		// reconciliation does not count as finished.
+		reconciliationcanceledcontext.SetCanceled(ctx)
 		return nil
 	}
 
 	{
-		ctx = reconciliationcanceledcontext.NewContext(ctx, make(chan struct{}))
-
 		defer func() {
 			ctx = unsetLoggerCtxValue(ctx, loggerKeyResource)
 		}()
 
-		for _, r := range c.resources {
-			ctx = setLoggerCtxValue(ctx, loggerKeyResource, r.Name())
+		for _, h := range c.handlers {
+			ctx = setLoggerCtxValue(ctx, loggerKeyResource, name.Name(h))
 			ctx = resourcecanceledcontext.NewContext(ctx, make(chan struct{}))
 
-			err := r.EnsureDeleted(ctx, obj)
+			res, err := c.ensureDeleted(ctx, h, obj)
+			if err != nil {
+				return microerror.Mask(&resourceError{resource: name.Name(h), err: err})
+			}
+
+			err = c.applyResponse(ctx, obj, res)
 			if err != nil {
 				return microerror.Mask(err)
 			}
@@ -485,6 +897,7 @@ func (c *Controller) deleteFunc(ctx context.Context, obj interface{}) error {
 	}
 
 	err = c.removeFinalizer(ctx, obj)
+	err = c.checkTimeout(ctx, "delete", finalizerResource, err)
 	if err != nil {
 		return microerror.Mask(err)
 	}
@@ -524,60 +937,234 @@ func (c *Controller) reconcile(ctx context.Context, req reconcile.Request, obj i
 		return reconcile.Result{}, nil
 	}
 
+	ctx = reconciliationcanceledcontext.NewContext(ctx, make(chan struct{}))
+
+	// The reconciliation timeout only applies to the execution of the
+	// resources, so that its outcome can still be written to the status of the
+	// runtime object once it timed out.
+	resourcesCtx := ctx
+	if c.reconcileTimeout != 0 {
+		var cancel context.CancelFunc
+		resourcesCtx, cancel = context.WithTimeout(ctx, c.reconcileTimeout)
+		defer cancel()
+	}
+
 	if m.GetDeletionTimestamp() != nil {
 		eventName := "delete"
 
-		t := prometheus.NewTimer(eventHistogram.WithLabelValues(eventName))
+		t := prometheus.NewTimer(eventHistogram.WithLabelValues(c.name, eventName))
 		ctx = setLoggerCtxValue(ctx, loggerKeyEvent, eventName)
 
-		err = c.deleteFunc(ctx, obj)
-		if err != nil {
-			return reconcile.Result{}, microerror.Mask(err)
+		err = c.deleteFunc(resourcesCtx, obj)
+		if err == nil {
+			t.ObserveDuration()
 		}
-
-		t.ObserveDuration()
 	} else {
 		eventName := "update"
 
-		t := prometheus.NewTimer(eventHistogram.WithLabelValues(eventName))
+		t := prometheus.NewTimer(eventHistogram.WithLabelValues(c.name, eventName))
 		ctx = setLoggerCtxValue(ctx, loggerKeyEvent, eventName)
 
-		err = c.updateFunc(ctx, obj)
-		if err != nil {
-			return reconcile.Result{}, microerror.Mask(err)
+		err = c.updateFunc(resourcesCtx, obj)
+		if err == nil {
+			t.ObserveDuration()
 		}
+	}
 
-		t.ObserveDuration()
+	// The outcome of finished or failed reconciliations is reflected in the
+	// status of the runtime object. Failing to do so fails the reconciliation
+	// unless it failed already anyway.
+	if c.readyCondition && !dryruncontext.IsDryRun(ctx) && (err != nil || !reconciliationcanceledcontext.IsCanceled(ctx)) {
+		statusErr := c.patchStatus(ctx, obj, err)
+		if statusErr != nil && err == nil {
+			err = statusErr
+		} else if statusErr != nil {
+			c.logger.Errorf(ctx, statusErr, "failed to patch status")
+		}
+	}
+
+	if err != nil {
+		return reconcile.Result{}, microerror.Mask(err)
 	}
 
 	return reconcile.Result{}, nil
 }
 
+// setBackOff tracks whether the runtime object of the given request is
+// currently being requeued with backoff due to reconciliation errors.
+func (c *Controller) setBackOff(req reconcile.Request, backOff bool) {
+	if !c.requeueOnError {
+		return
+	}
+
+	var n int
+	if backOff {
+		n = c.backOffObjects.Add(req.NamespacedName.String())
+	} else {
+		n = c.backOffObjects.Delete(req.NamespacedName.String())
+	}
+
+	backOffGauge.WithLabelValues(c.name).Set(float64(n))
+}
+
+// deleteObjectMetrics removes all metrics tracked for the runtime object of the
+// given request once it is gone.
+func (c *Controller) deleteObjectMetrics(req reconcile.Request) {
+	c.setFailing(req, false)
+	lastReconciledGauge.DeleteLabelValues(c.name, req.NamespacedName.String())
+}
+
+func (c *Controller) setFailing(req reconcile.Request, failing bool) {
+	var n int
+	if failing {
+		n = c.failingObjects.Add(req.NamespacedName.String())
+	} else {
+		n = c.failingObjects.Delete(req.NamespacedName.String())
+	}
+
+	failingGauge.WithLabelValues(c.name).Set(float64(n))
+}
+
+func (c *Controller) setLeader(ctx context.Context, leader bool) {
+	if leader {
+		c.logger.Debugf(ctx, "acquired leadership")
+		leaderGauge.WithLabelValues(c.name).Set(1)
+	} else {
+		c.logger.Debugf(ctx, "lost leadership")
+		leaderGauge.WithLabelValues(c.name).Set(0)
+	}
+
+	if c.leaderElectionChangeFunc != nil {
+		c.leaderElectionChangeFunc(ctx, leader)
+	}
+}
+
+// setup registers the controller with the given manager.
+func (c *Controller) setup(ctx context.Context, mgr manager.Manager) error {
+	var err error
+
+	// Boot the collector.
+	err = c.collector.Boot(ctx)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	// We register the controller with the process wide k8s error handling so
+	// that third party runtime errors end up in our log streams. The format is
+	// way easier to parse for us that way. The errors are counted separately
+	// from reconciliation errors, see thirdPartyErrors.
+	c.runtimeErrorOnce.Do(func() {
+		c.unregisterRuntimeErrors = runtimeErrors.Register(func(ctx context.Context, err error, _ string, _ ...interface{}) {
+			c.logger.Errorf(ctx, err, "caught third party runtime error")
+		})
+	})
+
+	// In-flight reconciliations are drained once the manager is stopped. See
+	// Reconcile.
+	err = mgr.Add(c.drainer)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	// The manager only starts runnables requiring leader election once the
+	// leader election lease was acquired. We use that to track leadership of
+	// the controller. Without leader election enabled the runnable is started
+	// right away.
+	err = mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		ctx = newLoggerCtx(ctx)
+		ctx = setLoggerCtxValue(ctx, loggerKeyController, c.name)
+
+		c.setLeader(ctx, true)
+		<-ctx.Done()
+		c.setLeader(ctx, false)
+
+		return nil
+	}))
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	{
+		// We build our controller and set up its reconciliation.
+		// We use the Complete() method instead of Build() because we don't
+		// need the controller instance.
+		//
+		// The controller is named after the operatorkit controller so that
+		// multiple operatorkit controllers reconciling the same kind can be
+		// registered with the same manager.
+		//
+		// The selector only filters the reconciled runtime objects. Owned and
+		// watched runtime objects are mapped to the reconciled runtime objects
+		// regardless of their labels, which is why Reconcile checks the
+		// selector again.
+		b := builder.
+			ControllerManagedBy(mgr).
+			Named(c.name).
+			For(c.newRuntimeObjectFunc(), builder.WithPredicates(predicate.Funcs{
+				CreateFunc:  func(e event.CreateEvent) bool { return c.selector.Matches(labels.Set(e.Object.GetLabels())) },
+				DeleteFunc:  func(e event.DeleteEvent) bool { return c.selector.Matches(labels.Set(e.Object.GetLabels())) },
+				UpdateFunc:  func(e event.UpdateEvent) bool { return c.selector.Matches(labels.Set(e.ObjectNew.GetLabels())) },
+				GenericFunc: func(e event.GenericEvent) bool { return c.selector.Matches(labels.Set(e.Object.GetLabels())) },
+			})).
+			WithOptions(controller.Options{
+				MaxConcurrentReconciles: c.maxConcurrentReconciles,
+				RateLimiter:             c.rateLimiter,
+			})
+
+		for _, o := range c.owns {
+			b = b.Owns(o)
+		}
+		for _, w := range c.watches {
+			b = b.Watches(w.Object, w.eventHandler())
+		}
+
+		err = b.Complete(c)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		// We put the controller into a booted state by closing its booted
+		// channel once so users know when to go ahead.
+		select {
+		case <-c.booted:
+		default:
+			close(c.booted)
+		}
+	}
+
+	return nil
+}
+
 func (c *Controller) updateFunc(ctx context.Context, obj interface{}) error {
 	var err error
 
 	ok, err := c.addFinalizer(ctx, obj)
+	err = c.checkTimeout(ctx, "update", finalizerResource, err)
 	if err != nil {
 		return microerror.Mask(err)
 	}
 	if ok {
 		// A finalizer was added, this causes a new update event, so we stop
 		// reconciling here and will pick up the new event.
+		reconciliationcanceledcontext.SetCanceled(ctx)
 		return nil
 	}
 
 	{
-		ctx = reconciliationcanceledcontext.NewContext(ctx, make(chan struct{}))
-
 		defer func() {
 			ctx = unsetLoggerCtxValue(ctx, loggerKeyResource)
 		}()
 
-		for _, r := range c.resources {
-			ctx = setLoggerCtxValue(ctx, loggerKeyResource, r.Name())
+		for _, h := range c.handlers {
+			ctx = setLoggerCtxValue(ctx, loggerKeyResource, name.Name(h))
 			ctx = resourcecanceledcontext.NewContext(ctx, make(chan struct{}))
 
-			err := r.EnsureCreated(ctx, obj)
+			res, err := c.ensureCreated(ctx, h, obj)
+			if err != nil {
+				return microerror.Mask(&resourceError{resource: name.Name(h), err: err})
+			}
+
+			err = c.applyResponse(ctx, obj, res)
 			if err != nil {
 				return microerror.Mask(err)
 			}
@@ -604,6 +1191,22 @@ func hasAnnotation(annotations map[string]string, targetKey string, targetValue
 	return actualValue == targetValue
 }
 
+// newLoggerCtx returns a new context carrying a copy of the logger meta found
+// in ctx, if any. Modifications of the copy via setLoggerCtxValue and
+// unsetLoggerCtxValue are then not visible to other users of ctx.
+func newLoggerCtx(ctx context.Context) context.Context {
+	m := loggermeta.New()
+
+	o, ok := loggermeta.FromContext(ctx)
+	if ok {
+		for k, v := range o.KeyVals {
+			m.KeyVals[k] = v
+		}
+	}
+
+	return loggermeta.NewContext(ctx, m)
+}
+
 func setLoggerCtxValue(ctx context.Context, key, value string) context.Context {
 	m, ok := loggermeta.FromContext(ctx)
 	if !ok {
@@ -616,15 +1219,45 @@ func setLoggerCtxValue(ctx context.Context, key, value string) context.Context {
 	return ctx
 }
 
-func setupSignalHandler(handle func()) {
+// setupSignalHandler calls handle once SIGINT or SIGTERM is received and exits
+// the process on the second signal. The returned function releases the signal
+// handler.
+func setupSignalHandler(handle func()) func() {
 	c := make(chan os.Signal, 2)
+	done := make(chan struct{})
 	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
-	go func() {
-		<-c
-		handle()
-		<-c
-		os.Exit(1) // second signal. Exit directly.
-	}()
+	go handleSignals(c, done, handle, os.Exit)
+
+	return func() {
+		signal.Stop(c)
+		close(done)
+	}
+}
+
+// handleSignals calls handle once the first signal is received on c and calls
+// exit on the second signal until done is closed. handle is executed in its own
+// goroutine, so that a second signal is noticed right away, even if handle
+// blocks, e.g. while in-flight reconciliations are drained.
+func handleSignals(c <-chan os.Signal, done <-chan struct{}, handle func(), exit func(int)) {
+	select {
+	case <-c:
+	case <-done:
+		return
+	}
+
+	go handle()
+
+	select {
+	case <-c:
+		// A signal racing with a clean stop must not exit the process.
+		select {
+		case <-done:
+			return
+		default:
+		}
+		exit(1) // second signal. Exit directly.
+	case <-done:
+	}
 }
 
 func unsetLoggerCtxValue(ctx context.Context, key string) context.Context {
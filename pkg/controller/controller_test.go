@@ -2,17 +2,39 @@ package controller
 
 import (
 	"context"
+	"os"
 	"reflect"
 	"strconv"
+	"syscall"
 	"testing"
+	"time"
 
+	"github.com/giantswarm/backoff/v2"
 	"github.com/giantswarm/k8sclient/v8/pkg/k8sclienttest"
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger/loggermeta"
 	"github.com/giantswarm/micrologger/microloggertest"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	pkgruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/giantswarm/operatorkit/v7/pkg/controller/context/dryruncontext"
+	"github.com/giantswarm/operatorkit/v7/pkg/controller/context/reconciliationcanceledcontext"
+	"github.com/giantswarm/operatorkit/v7/pkg/controller/context/requeueaftercontext"
+	"github.com/giantswarm/operatorkit/v7/pkg/controller/context/resourcecanceledcontext"
+	"github.com/giantswarm/operatorkit/v7/pkg/handler"
 	"github.com/giantswarm/operatorkit/v7/pkg/resource"
 )
 
@@ -31,6 +53,1018 @@ func Test_Controller_Collector_Register_Error(t *testing.T) {
 	}
 }
 
+func Test_Controller_New_MaxConcurrentReconciles(t *testing.T) {
+	testCases := []struct {
+		name                    string
+		maxConcurrentReconciles int
+		expected                int
+		errorMatcher            func(error) bool
+	}{
+		{
+			name:                    "case 0: unset defaults to sequential reconciliation",
+			maxConcurrentReconciles: 0,
+			expected:                DefaultMaxConcurrentReconciles,
+		},
+		{
+			name:                    "case 1: concurrent reconciliation",
+			maxConcurrentReconciles: 5,
+			expected:                5,
+		},
+		{
+			name:                    "case 2: negative value is invalid",
+			maxConcurrentReconciles: -1,
+			errorMatcher:            IsInvalidConfig,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			c := newTestConfig("test")
+			c.MaxConcurrentReconciles = tc.maxConcurrentReconciles
+
+			controller, err := New(c)
+
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				// correct; carry on
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+
+			if tc.errorMatcher != nil {
+				return
+			}
+
+			if controller.maxConcurrentReconciles != tc.expected {
+				t.Fatalf("expected %d max concurrent reconciles got %d", tc.expected, controller.maxConcurrentReconciles)
+			}
+		})
+	}
+}
+
+func Test_Controller_New_LeaderElection(t *testing.T) {
+	testCases := []struct {
+		name         string
+		config       func(c Config) Config
+		errorMatcher func(error) bool
+	}{
+		{
+			name: "case 0: leader election with defaults",
+			config: func(c Config) Config {
+				c.LeaderElection = true
+				return c
+			},
+		},
+		{
+			name: "case 1: renew deadline smaller than lease duration",
+			config: func(c Config) Config {
+				c.LeaderElection = true
+				c.LeaderElectionLeaseDuration = 15 * time.Second
+				c.LeaderElectionRenewDeadline = 10 * time.Second
+				return c
+			},
+		},
+		{
+			name: "case 2: renew deadline equal to lease duration",
+			config: func(c Config) Config {
+				c.LeaderElection = true
+				c.LeaderElectionLeaseDuration = 10 * time.Second
+				c.LeaderElectionRenewDeadline = 10 * time.Second
+				return c
+			},
+			errorMatcher: IsInvalidConfig,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c, err := New(tc.config(newTestConfig("leader-election")))
+
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				if c.leaderElectionID != "leader-election" {
+					t.Fatalf("expected leader election ID %#q got %#q", "leader-election", c.leaderElectionID)
+				}
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+		})
+	}
+}
+
+func Test_Controller_Reconcile_Result(t *testing.T) {
+	testCases := []struct {
+		name             string
+		reconcileTimeout time.Duration
+		requeueOnError   bool
+		resourceTimeout  time.Duration
+		ensureCreated    func(ctx context.Context) error
+		expectedResult   reconcile.Result
+		errorMatcher     func(error) bool
+	}{
+		{
+			name:           "case 0: successful reconciliation",
+			ensureCreated:  nil,
+			expectedResult: reconcile.Result{},
+		},
+		{
+			name: "case 1: successful reconciliation with requeue requests",
+			ensureCreated: func(ctx context.Context) error {
+				requeueaftercontext.SetRequeueAfter(ctx, time.Minute)
+				requeueaftercontext.SetRequeueAfter(ctx, time.Second)
+				return nil
+			},
+			expectedResult: reconcile.Result{RequeueAfter: time.Second},
+		},
+		{
+			name: "case 2: failed reconciliation swallows error",
+			ensureCreated: func(ctx context.Context) error {
+				return microerror.Mask(executionFailedError)
+			},
+			expectedResult: reconcile.Result{},
+		},
+		{
+			name: "case 3: failed reconciliation keeps requeue requests",
+			ensureCreated: func(ctx context.Context) error {
+				requeueaftercontext.SetRequeueAfter(ctx, time.Second)
+				return microerror.Mask(executionFailedError)
+			},
+			expectedResult: reconcile.Result{RequeueAfter: time.Second},
+		},
+		{
+			name:           "case 4: failed reconciliation returns error to requeue with backoff",
+			requeueOnError: true,
+			ensureCreated: func(ctx context.Context) error {
+				requeueaftercontext.SetRequeueAfter(ctx, time.Second)
+				return microerror.Mask(executionFailedError)
+			},
+			expectedResult: reconcile.Result{},
+			errorMatcher:   IsExecutionFailed,
+		},
+		{
+			name:            "case 5: resource exceeding the resource timeout returns error to requeue with backoff",
+			resourceTimeout: time.Millisecond,
+			ensureCreated: func(ctx context.Context) error {
+				<-ctx.Done()
+				return microerror.Mask(ctx.Err())
+			},
+			expectedResult: reconcile.Result{},
+			errorMatcher:   IsTimeout,
+		},
+		{
+			name:             "case 6: resource failing after exceeding the reconcile timeout returns error to requeue with backoff",
+			reconcileTimeout: time.Millisecond,
+			ensureCreated: func(ctx context.Context) error {
+				<-ctx.Done()
+				return microerror.Mask(executionFailedError)
+			},
+			expectedResult: reconcile.Result{},
+			errorMatcher:   IsTimeout,
+		},
+		{
+			name:             "case 7: resource finishing within the timeouts succeeds",
+			reconcileTimeout: time.Minute,
+			resourceTimeout:  time.Minute,
+			ensureCreated: func(ctx context.Context) error {
+				return nil
+			},
+			expectedResult: reconcile.Result{},
+		},
+		{
+			name:             "case 8: resource succeeding despite exceeding the reconcile timeout succeeds",
+			reconcileTimeout: time.Millisecond,
+			ensureCreated: func(ctx context.Context) error {
+				<-ctx.Done()
+				return nil
+			},
+			expectedResult: reconcile.Result{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			obj := &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "test-service",
+					Namespace:  "default",
+					Finalizers: []string{GetFinalizerName("test")},
+				},
+			}
+
+			c := newTestConfig("test")
+			c.K8sClient = k8sclienttest.NewClients(k8sclienttest.ClientsConfig{
+				CtrlClient: fake.NewClientBuilder().WithObjects(obj).Build(),
+			})
+			c.ReconcileTimeout = tc.reconcileTimeout
+			c.RequeueOnError = tc.requeueOnError
+			c.ResourceTimeout = tc.resourceTimeout
+			c.Resources = []resource.Interface{
+				&testResource{ensureCreated: tc.ensureCreated},
+			}
+
+			controller, err := New(c)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			req := reconcile.Request{NamespacedName: client.ObjectKeyFromObject(obj)}
+			result, err := controller.Reconcile(context.Background(), req)
+
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				// correct; carry on
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+
+			if result != tc.expectedResult {
+				t.Fatalf("expected result %#v got %#v", tc.expectedResult, result)
+			}
+		})
+	}
+}
+
+func Test_Controller_Reconcile_FinalizerTimeout(t *testing.T) {
+	obj := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-service",
+			Namespace: "default",
+		},
+	}
+
+	// Fetching the runtime object in order to add the finalizer blocks until
+	// the reconcile timeout is exceeded. Fetching the reconciled runtime
+	// object itself is not subject to the reconcile timeout.
+	ctrlClient := fake.NewClientBuilder().
+		WithObjects(obj).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+				if _, ok := ctx.Deadline(); ok {
+					<-ctx.Done()
+					return microerror.Mask(ctx.Err())
+				}
+
+				return c.Get(ctx, key, obj, opts...)
+			},
+		}).
+		Build()
+
+	c := newTestConfig("test")
+	c.K8sClient = k8sclienttest.NewClients(k8sclienttest.ClientsConfig{
+		CtrlClient: ctrlClient,
+	})
+	c.ReconcileTimeout = time.Millisecond
+	c.RequeueOnError = true
+
+	controller, err := New(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	controller.backOffFactory = func() backoff.Interface { return backoff.NewMaxRetries(0, 0) }
+
+	_, err = controller.Reconcile(context.Background(), reconcile.Request{NamespacedName: client.ObjectKeyFromObject(obj)})
+	if !IsTimeout(err) {
+		t.Fatalf("error == %#v, want matching", err)
+	}
+}
+
+func Test_Controller_Reconcile_HandlerResponse(t *testing.T) {
+	obj := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "test-service",
+			Namespace:  "default",
+			Finalizers: []string{GetFinalizerName("test")},
+		},
+	}
+	ctrlClient := fake.NewClientBuilder().WithObjects(obj).WithStatusSubresource(obj).Build()
+
+	c := newTestConfig("test")
+	c.K8sClient = k8sclienttest.NewClients(k8sclienttest.ClientsConfig{
+		CtrlClient: ctrlClient,
+	})
+	c.Handlers = []handler.Interface{
+		&testHandler{
+			ensureCreated: func(ctx context.Context, req handler.Request) (*handler.Response, error) {
+				return &handler.Response{RequeueAfter: time.Minute}, nil
+			},
+		},
+		&testHandler{
+			ensureCreated: func(ctx context.Context, req handler.Request) (*handler.Response, error) {
+				svc := req.Obj.(*corev1.Service)
+				patch := client.MergeFrom(svc.DeepCopy())
+				svc.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{{Hostname: "example.com"}}
+
+				return &handler.Response{RequeueAfter: time.Second, StatusPatch: patch}, nil
+			},
+		},
+	}
+
+	controller, err := New(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := reconcile.Request{NamespacedName: client.ObjectKeyFromObject(obj)}
+	result, err := controller.Reconcile(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedResult := reconcile.Result{RequeueAfter: time.Second}
+	if result != expectedResult {
+		t.Fatalf("expected result %#v got %#v", expectedResult, result)
+	}
+
+	svc := &corev1.Service{}
+	err = ctrlClient.Get(context.Background(), req.NamespacedName, svc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(svc.Status.LoadBalancer.Ingress) != 1 {
+		t.Fatalf("expected status to be patched")
+	}
+}
+
+func Test_Controller_Reconcile_ResourceMetrics(t *testing.T) {
+	testCases := []struct {
+		name                   string
+		ensureCreated          func(ctx context.Context) error
+		expectedErrors         float64
+		expectedCanceled       float64
+		expectedCanceledReason string
+	}{
+		{
+			name: "case 0: successful resources are not counted as failed or canceled",
+			ensureCreated: func(ctx context.Context) error {
+				return nil
+			},
+		},
+		{
+			name: "case 1: failed resources are counted by error kind",
+			ensureCreated: func(ctx context.Context) error {
+				return microerror.Mask(executionFailedError)
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "case 2: resources canceling themselves are counted",
+			ensureCreated: func(ctx context.Context) error {
+				resourcecanceledcontext.SetCanceled(ctx)
+				return nil
+			},
+			expectedCanceled:       1,
+			expectedCanceledReason: canceledResource,
+		},
+		{
+			name: "case 3: resources canceling the reconciliation are counted",
+			ensureCreated: func(ctx context.Context) error {
+				reconciliationcanceledcontext.SetCanceled(ctx)
+				return nil
+			},
+			expectedCanceled:       1,
+			expectedCanceledReason: canceledReconciliation,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			// Each test case uses its own controller name so that the
+			// metrics of the test cases do not interfere.
+			controllerName := "test-resource-metrics-" + strconv.Itoa(i)
+
+			obj := &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "test-service",
+					Namespace:  "default",
+					Finalizers: []string{GetFinalizerName(controllerName)},
+				},
+			}
+
+			c := newTestConfig(controllerName)
+			c.K8sClient = k8sclienttest.NewClients(k8sclienttest.ClientsConfig{
+				CtrlClient: fake.NewClientBuilder().WithObjects(obj).Build(),
+			})
+			c.Resources = []resource.Interface{
+				&testResource{ensureCreated: tc.ensureCreated},
+			}
+
+			controller, err := New(c)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			req := reconcile.Request{NamespacedName: client.ObjectKeyFromObject(obj)}
+			_, err = controller.Reconcile(context.Background(), req)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var m dto.Metric
+			err = resourceHistogram.WithLabelValues(controllerName, "update", "testResource").(prometheus.Metric).Write(&m)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if m.GetHistogram().GetSampleCount() != 1 {
+				t.Fatalf("expected %d observed durations got %d", 1, m.GetHistogram().GetSampleCount())
+			}
+
+			errors := testutil.ToFloat64(resourceErrorCounter.WithLabelValues(controllerName, "update", "testResource", "executionFailedError"))
+			if errors != tc.expectedErrors {
+				t.Fatalf("expected %v errors got %v", tc.expectedErrors, errors)
+			}
+
+			for _, reason := range []string{canceledReconciliation, canceledResource} {
+				var expected float64
+				if reason == tc.expectedCanceledReason {
+					expected = tc.expectedCanceled
+				}
+
+				canceled := testutil.ToFloat64(resourceCanceledCounter.WithLabelValues(controllerName, "update", "testResource", reason))
+				if canceled != expected {
+					t.Fatalf("expected %v %s cancelations got %v", expected, reason, canceled)
+				}
+			}
+		})
+	}
+}
+
+func Test_Controller_Reconcile_ObjectMetrics(t *testing.T) {
+	// The controller name is unique to this test so that the metrics of other
+	// tests do not interfere.
+	controllerName := "test-object-metrics"
+
+	obj := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "test-service",
+			Namespace:  "default",
+			Finalizers: []string{GetFinalizerName(controllerName)},
+		},
+	}
+
+	var failing bool
+
+	ctrlClient := fake.NewClientBuilder().WithObjects(obj).Build()
+
+	c := newTestConfig(controllerName)
+	c.K8sClient = k8sclienttest.NewClients(k8sclienttest.ClientsConfig{
+		CtrlClient: ctrlClient,
+	})
+	c.Resources = []resource.Interface{
+		&testResource{
+			ensureCreated: func(ctx context.Context) error {
+				if failing {
+					return microerror.Mask(executionFailedError)
+				}
+
+				return nil
+			},
+		},
+	}
+
+	controller, err := New(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := reconcile.Request{NamespacedName: client.ObjectKeyFromObject(obj)}
+	key := req.NamespacedName.String()
+
+	failing = true
+	for i := 0; i < 2; i++ {
+		_, err = controller.Reconcile(context.Background(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if n := testutil.ToFloat64(reconcileErrors.WithLabelValues(controllerName)); n != 2 {
+		t.Fatalf("expected %d errors got %v", 2, n)
+	}
+	if n := testutil.ToFloat64(failingGauge.WithLabelValues(controllerName)); n != 1 {
+		t.Fatalf("expected %d failing objects got %v", 1, n)
+	}
+	if _, ok := lastReconciled(t, controllerName, key); ok {
+		t.Fatalf("expected last reconciled timestamp to be unset")
+	}
+
+	failing = false
+	_, err = controller.Reconcile(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if n := testutil.ToFloat64(reconcileErrors.WithLabelValues(controllerName)); n != 2 {
+		t.Fatalf("expected %d errors got %v", 2, n)
+	}
+	if n := testutil.ToFloat64(failingGauge.WithLabelValues(controllerName)); n != 0 {
+		t.Fatalf("expected %d failing objects got %v", 0, n)
+	}
+	if v, ok := lastReconciled(t, controllerName, key); !ok || v == 0 {
+		t.Fatalf("expected last reconciled timestamp to be set")
+	}
+
+	// Once the runtime object is gone its metrics are removed. The first
+	// reconciliation after deleting the runtime object removes the finalizer,
+	// the second one does not find the runtime object anymore.
+	err = ctrlClient.Delete(context.Background(), obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		_, err = controller.Reconcile(context.Background(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if n := testutil.ToFloat64(failingGauge.WithLabelValues(controllerName)); n != 0 {
+		t.Fatalf("expected %d failing objects got %v", 0, n)
+	}
+	if _, ok := lastReconciled(t, controllerName, key); ok {
+		t.Fatalf("expected last reconciled timestamp to be removed")
+	}
+}
+
+func Test_Controller_Reconcile_Selector(t *testing.T) {
+	testCases := []struct {
+		name             string
+		labels           map[string]string
+		expectedExecuted bool
+	}{
+		{
+			name:             "case 0: runtime object matching the selector is reconciled",
+			labels:           map[string]string{"app": "test"},
+			expectedExecuted: true,
+		},
+		{
+			name:             "case 1: runtime object not matching the selector is skipped",
+			labels:           map[string]string{"app": "other"},
+			expectedExecuted: false,
+		},
+		{
+			name:             "case 2: runtime object without labels is skipped",
+			labels:           nil,
+			expectedExecuted: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			obj := &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "test-service",
+					Namespace:  "default",
+					Labels:     tc.labels,
+					Finalizers: []string{GetFinalizerName("test")},
+				},
+			}
+
+			var executed bool
+
+			c := newTestConfig("test")
+			c.K8sClient = k8sclienttest.NewClients(k8sclienttest.ClientsConfig{
+				CtrlClient: fake.NewClientBuilder().WithObjects(obj).Build(),
+			})
+			c.Resources = []resource.Interface{
+				&testResource{
+					ensureCreated: func(ctx context.Context) error {
+						executed = true
+						return nil
+					},
+				},
+			}
+			c.Selector = labels.SelectorFromSet(labels.Set{"app": "test"})
+
+			controller, err := New(c)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = controller.Reconcile(context.Background(), reconcile.Request{NamespacedName: client.ObjectKeyFromObject(obj)})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if executed != tc.expectedExecuted {
+				t.Fatalf("expected executed %t got %t", tc.expectedExecuted, executed)
+			}
+		})
+	}
+}
+
+// lastReconciled returns the last reconciled timestamp of the given runtime
+// object and whether it is tracked at all.
+func lastReconciled(t *testing.T, controller string, object string) (float64, bool) {
+	ch := make(chan prometheus.Metric, 100)
+	lastReconciledGauge.Collect(ch)
+	close(ch)
+
+	for m := range ch {
+		var d dto.Metric
+		err := m.Write(&d)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		labels := map[string]string{}
+		for _, l := range d.GetLabel() {
+			labels[l.GetName()] = l.GetValue()
+		}
+		if labels["controller"] == controller && labels["object"] == object {
+			return d.GetGauge().GetValue(), true
+		}
+	}
+
+	return 0, false
+}
+
+func Test_Controller_Reconcile_DryRun(t *testing.T) {
+	obj := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-service",
+			Namespace: "default",
+		},
+	}
+	ctrlClient := fake.NewClientBuilder().WithObjects(obj).WithStatusSubresource(obj).Build()
+
+	c := newTestConfig("test")
+	c.DryRun = true
+	c.K8sClient = k8sclienttest.NewClients(k8sclienttest.ClientsConfig{
+		CtrlClient: ctrlClient,
+	})
+	c.Resources = []resource.Interface{
+		&testResource{
+			ensureCreated: func(ctx context.Context) error {
+				dryruncontext.AddChange(ctx, dryruncontext.Change{Resource: "test", Operation: "create"})
+				return nil
+			},
+		},
+	}
+	c.Handlers = []handler.Interface{
+		&testHandler{
+			ensureCreated: func(ctx context.Context, req handler.Request) (*handler.Response, error) {
+				svc := req.Obj.(*corev1.Service)
+				patch := client.MergeFrom(svc.DeepCopy())
+				svc.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{{Hostname: "example.com"}}
+
+				return &handler.Response{StatusPatch: patch}, nil
+			},
+		},
+	}
+
+	controller, err := New(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := reconcile.Request{NamespacedName: client.ObjectKeyFromObject(obj)}
+
+	_, ok := controller.Plan(req.NamespacedName)
+	if ok {
+		t.Fatalf("expected no plan before reconciliation")
+	}
+
+	_, err = controller.Reconcile(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	changes, ok := controller.Plan(req.NamespacedName)
+	if !ok {
+		t.Fatalf("expected plan after reconciliation")
+	}
+	expectedChanges := []dryruncontext.Change{{Resource: "test", Operation: "create"}}
+	if !reflect.DeepEqual(changes, expectedChanges) {
+		t.Fatalf("expected changes %#v got %#v", expectedChanges, changes)
+	}
+
+	svc := &corev1.Service{}
+	err = ctrlClient.Get(context.Background(), req.NamespacedName, svc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(svc.Finalizers) != 0 {
+		t.Fatalf("expected no finalizers got %v", svc.Finalizers)
+	}
+	if len(svc.Status.LoadBalancer.Ingress) != 0 {
+		t.Fatalf("expected status not to be patched")
+	}
+}
+
+func Test_Controller_Reconcile_ReadyCondition(t *testing.T) {
+	testCases := []struct {
+		name            string
+		ensureCreated   func(ctx context.Context) error
+		expectedStatus  metav1.ConditionStatus
+		expectedReason  string
+		expectedMessage string
+	}{
+		{
+			name: "case 0: successful reconciliations set ready to true",
+			ensureCreated: func(ctx context.Context) error {
+				return nil
+			},
+			expectedStatus:  metav1.ConditionTrue,
+			expectedReason:  ReasonReconciled,
+			expectedMessage: "",
+		},
+		{
+			name: "case 1: failed reconciliations set ready to false",
+			ensureCreated: func(ctx context.Context) error {
+				return microerror.Maskf(&microerror.Error{Kind: "testError"}, "test message")
+			},
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  "TestError",
+			expectedMessage: "Resource testResource failed: test error: test message",
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			obj := &testStatusObject{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "test",
+					Namespace:  "default",
+					Generation: 3,
+				},
+			}
+
+			s := pkgruntime.NewScheme()
+			s.AddKnownTypes(schema.GroupVersion{Group: "test.giantswarm.io", Version: "v1"}, &testStatusObject{})
+
+			ctrlClient := fake.NewClientBuilder().WithScheme(s).WithObjects(obj).WithStatusSubresource(obj).Build()
+
+			c := newTestConfig("test")
+			c.K8sClient = k8sclienttest.NewClients(k8sclienttest.ClientsConfig{
+				CtrlClient: ctrlClient,
+			})
+			c.NewRuntimeObjectFunc = func() client.Object {
+				return new(testStatusObject)
+			}
+			c.ReadyCondition = true
+			c.Resources = []resource.Interface{
+				&testResource{
+					ensureCreated: tc.ensureCreated,
+				},
+			}
+
+			controller, err := New(c)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			req := reconcile.Request{NamespacedName: client.ObjectKeyFromObject(obj)}
+
+			// The first reconciliation only adds the finalizer and is
+			// therefore not reflected in the status.
+			for j := 0; j < 2; j++ {
+				_, err = controller.Reconcile(context.Background(), req)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				latest := &testStatusObject{}
+				err = ctrlClient.Get(context.Background(), req.NamespacedName, latest)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if j == 0 {
+					if len(latest.Status.Conditions) != 0 {
+						t.Fatalf("expected no conditions got %#v", latest.Status.Conditions)
+					}
+					continue
+				}
+
+				if latest.Status.ObservedGeneration != 3 {
+					t.Fatalf("expected observed generation %d got %d", 3, latest.Status.ObservedGeneration)
+				}
+				if len(latest.Status.Conditions) != 1 {
+					t.Fatalf("expected one condition got %#v", latest.Status.Conditions)
+				}
+				ready := latest.Status.Conditions[0]
+				if ready.Type != "Ready" || ready.Status != tc.expectedStatus || ready.Reason != tc.expectedReason || ready.Message != tc.expectedMessage {
+					t.Fatalf("unexpected ready condition %#v", ready)
+				}
+			}
+		})
+	}
+}
+
+func Test_Controller_BootWithError(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config func(c Config) Config
+	}{
+		{
+			name: "case 0: invalid REST config fails to boot",
+			config: func(c Config) Config {
+				c.K8sClient = k8sclienttest.NewClients(k8sclienttest.ClientsConfig{
+					RestConfig: &rest.Config{
+						Host: "https://127.0.0.1:6443",
+						TLSClientConfig: rest.TLSClientConfig{
+							CAFile: "/does/not/exist",
+						},
+					},
+				})
+				return c
+			},
+		},
+		{
+			name: "case 1: leader election outside of a cluster without namespace fails to boot",
+			config: func(c Config) Config {
+				c.K8sClient = k8sclienttest.NewClients(k8sclienttest.ClientsConfig{
+					RestConfig: &rest.Config{Host: "https://127.0.0.1:6443"},
+				})
+				c.LeaderElection = true
+				return c
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			c, err := New(tc.config(newTestConfig("test-boot-" + strconv.Itoa(i))))
+			if err != nil {
+				t.Fatal(err)
+			}
+			c.backOffFactory = func() backoff.Interface { return backoff.NewMaxRetries(0, 0) }
+
+			// BootWithError must return the boot failure instead of exiting the
+			// process, which would abort the test.
+			err = c.BootWithError(context.Background())
+			if err == nil {
+				t.Fatalf("error == nil, want non-nil")
+			}
+
+			select {
+			case <-c.stopped:
+			default:
+				t.Fatalf("expected controller to be stopped")
+			}
+		})
+	}
+}
+
+func Test_Controller_Stop(t *testing.T) {
+	c := newTestConfig("test-stop")
+	c.K8sClient = k8sclienttest.NewClients(k8sclienttest.ClientsConfig{
+		RestConfig: &rest.Config{Host: "https://127.0.0.1:6443"},
+	})
+
+	controller, err := New(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- controller.BootWithError(context.Background())
+	}()
+
+	select {
+	case <-controller.Booted():
+	case <-time.After(10 * time.Second):
+		t.Fatalf("expected controller to be booted")
+	}
+
+	controller.Stop(context.Background())
+
+	// Stop must only return once the controller stopped completely.
+	select {
+	case <-controller.stopped:
+	default:
+		t.Fatalf("expected controller to be stopped once Stop returned")
+	}
+
+	err = <-errs
+	if err != nil {
+		t.Fatalf("error == %#v, want nil", err)
+	}
+}
+
+func Test_Controller_New_ReadyCondition(t *testing.T) {
+	c := newTestConfig("test")
+	c.ReadyCondition = true
+
+	_, err := New(c)
+	if !IsInvalidConfig(err) {
+		t.Fatalf("expected invalid config error got %#v", err)
+	}
+}
+
+func Test_Controller_SetupWithManager(t *testing.T) {
+	mgr, err := manager.New(&rest.Config{Host: "https://127.0.0.1:6443"}, manager.Options{
+		Metrics: server.Options{
+			BindAddress: DisableMetricsServing,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, n := range []string{"shared-1", "shared-2"} {
+		c := mustNewTestController(n)
+
+		err = c.SetupWithManager(mgr)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		select {
+		case <-c.Booted():
+		default:
+			t.Fatalf("expected controller %#q to be booted", n)
+		}
+	}
+}
+
+func Test_handleSignals_exits_on_second_signal_during_drain(t *testing.T) {
+	c := make(chan os.Signal, 2)
+	done := make(chan struct{})
+	exited := make(chan int, 1)
+
+	// The drain blocks until the test finishes, like a hanging graceful
+	// shutdown.
+	drain := make(chan struct{})
+	defer close(drain)
+
+	handled := make(chan struct{})
+	handle := func() {
+		close(handled)
+		<-drain
+	}
+
+	go handleSignals(c, done, handle, func(code int) { exited <- code })
+
+	c <- os.Interrupt
+	<-handled
+	c <- syscall.SIGTERM
+
+	select {
+	case code := <-exited:
+		if code != 1 {
+			t.Fatalf("expected exit code %d got %d", 1, code)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatalf("expected second signal to exit")
+	}
+}
+
+func Test_handleSignals_does_not_exit_on_clean_stop(t *testing.T) {
+	// The outcome used to depend on which channel the select picked, which is
+	// why the scenario is repeated.
+	for i := 0; i < 100; i++ {
+		c := make(chan os.Signal, 2)
+		done := make(chan struct{})
+		exited := make(chan int, 1)
+		returned := make(chan struct{})
+
+		handled := make(chan struct{})
+		handle := func() {
+			close(handled)
+		}
+
+		go func() {
+			defer close(returned)
+			handleSignals(c, done, handle, func(code int) { exited <- code })
+		}()
+
+		c <- os.Interrupt
+		<-handled
+		close(done)
+		<-returned
+
+		select {
+		case code := <-exited:
+			t.Fatalf("expected no exit got exit code %d", code)
+		default:
+		}
+	}
+}
+
 func Test_setLoggerCtxValue_doesnt_leak(t *testing.T) {
 	ctx := context.Background()
 
@@ -51,6 +1085,34 @@ func Test_setLoggerCtxValue_doesnt_leak(t *testing.T) {
 	}
 }
 
+func Test_newLoggerCtx_isolates_values(t *testing.T) {
+	ctx := setLoggerCtxValue(context.Background(), "controller", "foo")
+
+	a := setLoggerCtxValue(newLoggerCtx(ctx), "loop", "1")
+	b := setLoggerCtxValue(newLoggerCtx(ctx), "loop", "2")
+
+	testCases := []struct {
+		ctx      context.Context
+		key      string
+		expected string
+	}{
+		{ctx: ctx, key: "loop", expected: ""},
+		{ctx: a, key: "loop", expected: "1"},
+		{ctx: b, key: "loop", expected: "2"},
+		{ctx: a, key: "controller", expected: "foo"},
+		{ctx: b, key: "controller", expected: "foo"},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			m, _ := loggermeta.FromContext(tc.ctx)
+			if m.KeyVals[tc.key] != tc.expected {
+				t.Fatalf("expected %#q got %#q", tc.expected, m.KeyVals[tc.key])
+			}
+		})
+	}
+}
+
 func Test_hasPauseAnnotation(t *testing.T) {
 	testCases := []struct {
 		annotations    map[string]string
@@ -150,44 +1212,102 @@ func isValueCtx(ctx interface{}) bool {
 }
 
 func mustNewTestController(n string) *Controller {
-	var err error
+	controller, err := New(newTestConfig(n))
+	if err != nil {
+		panic(err)
+	}
 
-	var controller *Controller
-	{
-		c := Config{
-			K8sClient: k8sclienttest.NewEmpty(),
-			Logger:    microloggertest.New(),
-			NewRuntimeObjectFunc: func() client.Object {
-				return new(corev1.Service)
-			},
-			Resources: []resource.Interface{
-				&testResource{},
-			},
-			Selector: labels.Everything(),
+	return controller
+}
 
-			Name: n,
-		}
+func newTestConfig(n string) Config {
+	c := Config{
+		K8sClient: k8sclienttest.NewEmpty(),
+		Logger:    microloggertest.New(),
+		NewRuntimeObjectFunc: func() client.Object {
+			return new(corev1.Service)
+		},
+		Resources: []resource.Interface{
+			&testResource{},
+		},
+		Selector: labels.Everything(),
 
-		controller, err = New(c)
-		if err != nil {
-			panic(err)
-		}
+		Name: n,
 	}
 
-	return controller
+	return c
 }
 
 type testResource struct {
+	ensureCreated func(ctx context.Context) error
 }
 
 func (r *testResource) Name() string {
-	return ""
+	return "testResource"
 }
 
 func (r *testResource) EnsureCreated(ctx context.Context, obj interface{}) error {
+	if r.ensureCreated != nil {
+		return r.ensureCreated(ctx)
+	}
+
 	return nil
 }
 
 func (r *testResource) EnsureDeleted(ctx context.Context, obj interface{}) error {
 	return nil
 }
+
+type testHandler struct {
+	ensureCreated func(ctx context.Context, req handler.Request) (*handler.Response, error)
+}
+
+func (h *testHandler) EnsureCreated(ctx context.Context, req handler.Request) (*handler.Response, error) {
+	return h.ensureCreated(ctx, req)
+}
+
+func (h *testHandler) EnsureDeleted(ctx context.Context, req handler.Request) (*handler.Response, error) {
+	return nil, nil
+}
+
+func (h *testHandler) Name() string {
+	return "test"
+}
+
+type testStatusObject struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Status            testStatus `json:"status,omitempty"`
+}
+
+type testStatus struct {
+	Conditions         []metav1.Condition `json:"conditions,omitempty"`
+	ObservedGeneration int64              `json:"observedGeneration,omitempty"`
+}
+
+func (o *testStatusObject) DeepCopyObject() pkgruntime.Object {
+	c := &testStatusObject{
+		TypeMeta: o.TypeMeta,
+		Status: testStatus{
+			ObservedGeneration: o.Status.ObservedGeneration,
+		},
+	}
+	o.ObjectMeta.DeepCopyInto(&c.ObjectMeta)
+	for _, condition := range o.Status.Conditions {
+		c.Status.Conditions = append(c.Status.Conditions, *condition.DeepCopy())
+	}
+
+	return c
+}
+
+func (o *testStatusObject) GetConditions() []metav1.Condition {
+	return o.Status.Conditions
+}
+
+func (o *testStatusObject) SetConditions(conditions []metav1.Condition) {
+	o.Status.Conditions = conditions
+}
+
+func (o *testStatusObject) SetObservedGeneration(generation int64) {
+	o.Status.ObservedGeneration = generation
+}
@@ -0,0 +1,55 @@
+package controller
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// drainer is a manager runnable providing the context in-flight
+// reconciliations are bound to. The context is canceled once the graceful
+// shutdown timeout is exceeded after the manager got stopped. Every time the
+// drainer is started by a manager, e.g. when the controller is booted again
+// after a failure, a fresh context is used. The drainer runs regardless of
+// leader election.
+type drainer struct {
+	mutex   sync.Mutex
+	ctx     context.Context
+	cancel  func()
+	timeout time.Duration
+}
+
+func newDrainer(timeout time.Duration) *drainer {
+	d := &drainer{
+		ctx:     context.Background(),
+		cancel:  func() {},
+		timeout: timeout,
+	}
+
+	return d
+}
+
+// Context returns the context in-flight reconciliations are bound to.
+func (d *drainer) Context() context.Context {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	return d.ctx
+}
+
+func (d *drainer) NeedLeaderElection() bool {
+	return false
+}
+
+func (d *drainer) Start(ctx context.Context) error {
+	d.mutex.Lock()
+	d.ctx, d.cancel = context.WithCancel(context.Background())
+	cancel := d.cancel
+	d.mutex.Unlock()
+
+	<-ctx.Done()
+
+	time.AfterFunc(d.timeout, cancel)
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func Test_drainer(t *testing.T) {
+	d := newDrainer(100 * time.Millisecond)
+
+	for i := 0; i < 2; i++ {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		done := make(chan struct{})
+		go func() {
+			_ = d.Start(ctx)
+			close(done)
+		}()
+
+		// Give the drainer some time to be started.
+		time.Sleep(10 * time.Millisecond)
+
+		drain := d.Context()
+		if drain.Err() != nil {
+			t.Fatalf("expected drain context not to be canceled before stopping")
+		}
+
+		cancel()
+		<-done
+
+		if drain.Err() != nil {
+			t.Fatalf("expected drain context not to be canceled before timeout")
+		}
+
+		select {
+		case <-drain.Done():
+		case <-time.After(time.Second):
+			t.Fatalf("expected drain context to be canceled after timeout")
+		}
+	}
+}
@@ -85,6 +85,16 @@ func IsStatusForbidden(err error) bool {
 	return false
 }
 
+var timeoutError = &microerror.Error{
+	Desc: "The operator canceled the reconciliation of the object since it timed out. The object is reconciled again later.",
+	Kind: "timeoutError",
+}
+
+// IsTimeout asserts timeoutError.
+func IsTimeout(err error) bool {
+	return microerror.Cause(err) == timeoutError
+}
+
 var tooManyResourceSetsError = &microerror.Error{
 	Desc: "Multiple resource sets to reconcile the same runtime object is not supported. There must only be one resource set configured.",
 	Kind: "tooManyResourceSetsError",
@@ -94,3 +104,12 @@ var tooManyResourceSetsError = &microerror.Error{
 func IsTooManyResourceSets(err error) bool {
 	return microerror.Cause(err) == tooManyResourceSetsError
 }
+
+var wrongTypeError = &microerror.Error{
+	Kind: "wrongTypeError",
+}
+
+// IsWrongTypeError asserts wrongTypeError.
+func IsWrongTypeError(err error) bool {
+	return microerror.Cause(err) == wrongTypeError
+}
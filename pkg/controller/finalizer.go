@@ -13,11 +13,15 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/giantswarm/operatorkit/v7/pkg/controller/context/dryruncontext"
 	"github.com/giantswarm/operatorkit/v7/pkg/controller/context/finalizerskeptcontext"
 )
 
 const (
 	finalizerPrefix = "operatorkit.giantswarm.io"
+	// finalizerResource is the resource name used to report timeouts of
+	// adding and removing finalizers.
+	finalizerResource = "finalizer"
 )
 
 type patchSpec struct {
@@ -37,6 +41,11 @@ func (c *Controller) addFinalizer(ctx context.Context, obj interface{}) (bool, e
 	if containsString(accessor.GetFinalizers(), GetFinalizerName(c.name)) {
 		return false, nil // object already has the finalizer.
 	}
+	// Runtime objects are not modified in dry-run mode.
+	if dryruncontext.IsDryRun(ctx) {
+		c.logger.Debugf(ctx, "not adding finalizer %#q in dry-run mode", GetFinalizerName(c.name))
+		return false, nil
+	}
 
 	var stopReconciliation bool
 	{
@@ -126,6 +135,13 @@ func (c *Controller) removeFinalizer(ctx context.Context, obj interface{}) error
 		return nil
 	}
 
+	// Runtime objects are not modified in dry-run mode.
+	if dryruncontext.IsDryRun(ctx) {
+		c.logger.Debugf(ctx, "not removing finalizer %#q in dry-run mode", finalizerName)
+
+		return nil
+	}
+
 	// The reconciled object has no finalizer being set. This could have several
 	// reasons. All these cases should not be harmful in general, so we ignore
 	// them.
@@ -0,0 +1,92 @@
+package controller
+
+import (
+	"context"
+	"errors"
+
+	"github.com/giantswarm/microerror"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/giantswarm/operatorkit/v7/pkg/controller/context/reconciliationcanceledcontext"
+	"github.com/giantswarm/operatorkit/v7/pkg/controller/context/resourcecanceledcontext"
+	"github.com/giantswarm/operatorkit/v7/pkg/controller/internal/name"
+	"github.com/giantswarm/operatorkit/v7/pkg/handler"
+)
+
+const (
+	canceledReconciliation = "reconciliation"
+	canceledResource       = "resource"
+)
+
+// errorKindUnknown is the kind label value of errors not specifying any
+// microerror kind.
+const errorKindUnknown = "unknown"
+
+// ensureCreated executes EnsureCreated of the given handler honouring the
+// configured resource timeout.
+func (c *Controller) ensureCreated(ctx context.Context, h handler.Interface, obj interface{}) (*handler.Response, error) {
+	res, err := c.ensure(ctx, "update", h, func(ctx context.Context) (*handler.Response, error) {
+		return h.EnsureCreated(ctx, handler.Request{Obj: obj})
+	})
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return res, nil
+}
+
+// ensureDeleted executes EnsureDeleted of the given handler honouring the
+// configured resource timeout.
+func (c *Controller) ensureDeleted(ctx context.Context, h handler.Interface, obj interface{}) (*handler.Response, error) {
+	res, err := c.ensure(ctx, "delete", h, func(ctx context.Context) (*handler.Response, error) {
+		return h.EnsureDeleted(ctx, handler.Request{Obj: obj})
+	})
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return res, nil
+}
+
+// ensure executes fn on behalf of the given handler and records the duration,
+// errors and cancelations of the execution, so that every resource is
+// instrumented without having to be wrapped by metricsresource.
+func (c *Controller) ensure(ctx context.Context, event string, h handler.Interface, fn func(ctx context.Context) (*handler.Response, error)) (*handler.Response, error) {
+	resourceName := name.Name(h)
+
+	t := prometheus.NewTimer(resourceHistogram.WithLabelValues(c.name, event, resourceName))
+	defer t.ObserveDuration()
+
+	var res *handler.Response
+	var err error
+	{
+		resourceCtx, cancel := c.newResourceCtx(ctx)
+		defer cancel()
+
+		res, err = fn(resourceCtx)
+		err = c.checkTimeout(resourceCtx, event, resourceName, err)
+		if err != nil {
+			resourceErrorCounter.WithLabelValues(c.name, event, resourceName, errorKind(err)).Inc()
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	if reconciliationcanceledcontext.IsCanceled(ctx) {
+		resourceCanceledCounter.WithLabelValues(c.name, event, resourceName, canceledReconciliation).Inc()
+	} else if resourcecanceledcontext.IsCanceled(ctx) {
+		resourceCanceledCounter.WithLabelValues(c.name, event, resourceName, canceledResource).Inc()
+	}
+
+	return res, nil
+}
+
+// errorKind returns the microerror kind of the given error, which is used as
+// metric label.
+func errorKind(err error) string {
+	var merr *microerror.Error
+	if errors.As(err, &merr) && merr.Kind != "" {
+		return merr.Kind
+	}
+
+	return errorKindUnknown
+}
@@ -7,7 +7,20 @@ import (
 	"github.com/giantswarm/operatorkit/v7/pkg/handler"
 )
 
+// Name returns the name of the given handler used for identification e.g. in
+// logging and metrics components. Handlers implementing a Name method, like
+// adapted resources, are identified by its result. All other handlers are
+// identified by the name of the package they are implemented in.
 func Name(r handler.Interface) string {
+	type namer interface {
+		Name() string
+	}
+
+	n, ok := r.(namer)
+	if ok {
+		return n.Name()
+	}
+
 	split := strings.Split(fmt.Sprintf("%T", r), ".")
 
 	if len(split) < 2 {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/giantswarm/operatorkit/v7/pkg/controller/internal/test/handler/bar"
 	"github.com/giantswarm/operatorkit/v7/pkg/controller/internal/test/handler/foo"
+	"github.com/giantswarm/operatorkit/v7/pkg/controller/internal/test/handler/named"
 	"github.com/giantswarm/operatorkit/v7/pkg/controller/internal/test/handler/nopointer"
 	"github.com/giantswarm/operatorkit/v7/pkg/handler"
 )
@@ -31,6 +32,11 @@ func Test_Handler_Name(t *testing.T) {
 			handler:      nopointer.Handler{},
 			expectedName: "nopointer",
 		},
+		{
+			name:         "case 3",
+			handler:      &named.Handler{},
+			expectedName: "custom",
+		},
 	}
 
 	for i, tc := range testCases {
@@ -0,0 +1,56 @@
+package controller
+
+import (
+	"sync"
+)
+
+// keyLock serializes work on a per key basis. Different keys can be locked
+// concurrently while the same key can only be locked once at a time. Entries
+// are removed as soon as nobody holds or waits for them anymore, so the memory
+// footprint only depends on the number of keys being processed concurrently.
+type keyLock struct {
+	mutex sync.Mutex
+	locks map[string]*keyLockEntry
+}
+
+type keyLockEntry struct {
+	mutex sync.Mutex
+	refs  int
+}
+
+func newKeyLock() *keyLock {
+	l := &keyLock{
+		locks: map[string]*keyLockEntry{},
+	}
+
+	return l
+}
+
+func (l *keyLock) Lock(key string) {
+	l.mutex.Lock()
+	e, ok := l.locks[key]
+	if !ok {
+		e = &keyLockEntry{}
+		l.locks[key] = e
+	}
+	e.refs++
+	l.mutex.Unlock()
+
+	e.mutex.Lock()
+}
+
+func (l *keyLock) Unlock(key string) {
+	l.mutex.Lock()
+	e, ok := l.locks[key]
+	if !ok {
+		l.mutex.Unlock()
+		return
+	}
+	e.refs--
+	if e.refs == 0 {
+		delete(l.locks, key)
+	}
+	l.mutex.Unlock()
+
+	e.mutex.Unlock()
+}
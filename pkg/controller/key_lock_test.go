@@ -0,0 +1,51 @@
+package controller
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func Test_keyLock_serializes_same_key(t *testing.T) {
+	l := newKeyLock()
+
+	var active int64
+	var wg sync.WaitGroup
+
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			l.Lock("same")
+			defer l.Unlock("same")
+
+			if atomic.AddInt64(&active, 1) != 1 {
+				t.Errorf("expected only one holder of the same key")
+			}
+			atomic.AddInt64(&active, -1)
+		}()
+	}
+
+	wg.Wait()
+
+	if len(l.locks) != 0 {
+		t.Fatalf("expected %d lock entries, got %d", 0, len(l.locks))
+	}
+}
+
+func Test_keyLock_allows_different_keys(t *testing.T) {
+	l := newKeyLock()
+
+	l.Lock("a")
+	defer l.Unlock("a")
+
+	done := make(chan struct{})
+	go func() {
+		l.Lock("b")
+		l.Unlock("b")
+		close(done)
+	}()
+
+	<-done
+}
@@ -0,0 +1,39 @@
+package controller
+
+import (
+	"sync"
+)
+
+// keySet is a set of object keys which is safe for concurrent use.
+type keySet struct {
+	mutex sync.Mutex
+	keys  map[string]struct{}
+}
+
+func newKeySet() *keySet {
+	s := &keySet{
+		keys: map[string]struct{}{},
+	}
+
+	return s
+}
+
+// Add adds the given key to the set and returns the size of the set.
+func (s *keySet) Add(key string) int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.keys[key] = struct{}{}
+
+	return len(s.keys)
+}
+
+// Delete removes the given key from the set and returns the size of the set.
+func (s *keySet) Delete(key string) int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.keys, key)
+
+	return len(s.keys)
+}
@@ -10,15 +10,43 @@ const (
 )
 
 var (
-	// ReconcileErrors is a prometheus counter metrics which holds the total
+	// reconcileErrors is a prometheus counter metric which holds the total
 	// number of errors from the Reconciler.
-	reconcileErrors = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+	reconcileErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
 		Namespace: PrometheusNamespace,
 		Subsystem: PrometheusSubsystem,
 		Name:      "errors_total",
 		Help:      "Total number of reconciliation errors per controller",
 	}, []string{"controller"})
-
+	// thirdPartyErrors is a prometheus counter metric which holds the total
+	// number of third party runtime errors. These are process wide and thus not
+	// attributed to any controller.
+	thirdPartyErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: PrometheusNamespace,
+			Subsystem: PrometheusSubsystem,
+			Name:      "third_party_errors_total",
+			Help:      "Total number of third party runtime errors caught by the k8s error handling of the process.",
+		},
+	)
+	backOffGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: PrometheusNamespace,
+			Subsystem: PrometheusSubsystem,
+			Name:      "backoff_objects",
+			Help:      "Number of runtime objects currently requeued with backoff due to reconciliation errors.",
+		},
+		[]string{"controller"},
+	)
+	failingGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: PrometheusNamespace,
+			Subsystem: PrometheusSubsystem,
+			Name:      "failing_objects",
+			Help:      "Number of runtime objects whose latest reconciliation failed.",
+		},
+		[]string{"controller"},
+	)
 	eventHistogram = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Namespace: PrometheusNamespace,
@@ -26,21 +54,74 @@ var (
 			Name:      "event",
 			Help:      "Histogram for events within the operatorkit controller.",
 		},
-		[]string{"event"},
+		[]string{"controller", "event"},
+	)
+	leaderGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: PrometheusNamespace,
+			Subsystem: PrometheusSubsystem,
+			Name:      "leader",
+			Help:      "Whether the controller is currently the elected leader reconciling runtime objects.",
+		},
+		[]string{"controller"},
+	)
+	resourceCanceledCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: PrometheusNamespace,
+			Subsystem: PrometheusSubsystem,
+			Name:      "resource_canceled_total",
+			Help:      "Number of resource executions which canceled either the resource itself or the whole reconciliation.",
+		},
+		[]string{"controller", "event", "resource", "canceled"},
+	)
+	resourceErrorCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: PrometheusNamespace,
+			Subsystem: PrometheusSubsystem,
+			Name:      "resource_errors_total",
+			Help:      "Number of resource executions which failed, labelled by the microerror kind of the error.",
+		},
+		[]string{"controller", "event", "resource", "kind"},
+	)
+	resourceHistogram = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: PrometheusNamespace,
+			Subsystem: PrometheusSubsystem,
+			Name:      "resource",
+			Help:      "Histogram for resource executions within the operatorkit controller.",
+		},
+		[]string{"controller", "event", "resource"},
+	)
+	resourceTimeoutCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: PrometheusNamespace,
+			Subsystem: PrometheusSubsystem,
+			Name:      "resource_timeouts_total",
+			Help:      "Number of resource executions canceled due to the reconciliation or resource timeout.",
+		},
+		[]string{"controller", "event", "resource"},
 	)
 	lastReconciledGauge = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: PrometheusNamespace,
 			Subsystem: PrometheusSubsystem,
 			Name:      "last_reconciled",
-			Help:      "Last reconciled Timestamp of watched runtime objects.",
+			Help:      "Timestamp of the latest successful reconciliation of watched runtime objects.",
 		},
-		[]string{"controller"},
+		[]string{"controller", "object"},
 	)
 )
 
 func init() {
 	prometheus.MustRegister(reconcileErrors)
+	prometheus.MustRegister(backOffGauge)
 	prometheus.MustRegister(eventHistogram)
+	prometheus.MustRegister(failingGauge)
+	prometheus.MustRegister(leaderGauge)
 	prometheus.MustRegister(lastReconciledGauge)
+	prometheus.MustRegister(resourceCanceledCounter)
+	prometheus.MustRegister(resourceErrorCounter)
+	prometheus.MustRegister(resourceHistogram)
+	prometheus.MustRegister(resourceTimeoutCounter)
+	prometheus.MustRegister(thirdPartyErrors)
 }
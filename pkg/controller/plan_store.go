@@ -0,0 +1,44 @@
+package controller
+
+import (
+	"sync"
+
+	"github.com/giantswarm/operatorkit/v7/pkg/controller/context/dryruncontext"
+)
+
+// planStore keeps the changes computed during the latest reconciliation of
+// each runtime object in dry-run mode.
+type planStore struct {
+	mutex sync.Mutex
+	plans map[string][]dryruncontext.Change
+}
+
+func newPlanStore() *planStore {
+	s := &planStore{
+		plans: map[string][]dryruncontext.Change{},
+	}
+
+	return s
+}
+
+func (s *planStore) Delete(key string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.plans, key)
+}
+
+func (s *planStore) Get(key string) ([]dryruncontext.Change, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	changes, ok := s.plans[key]
+	return changes, ok
+}
+
+func (s *planStore) Set(key string, changes []dryruncontext.Change) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.plans[key] = changes
+}
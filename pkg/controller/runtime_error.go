@@ -0,0 +1,78 @@
+package controller
+
+import (
+	"context"
+	"sync"
+
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+)
+
+// runtimeErrors is the process wide registry of handlers being notified about
+// third party runtime errors caught by the k8s error handling.
+var runtimeErrors = &runtimeErrorRegistry{
+	handlers: map[int]utilruntime.ErrorHandler{},
+}
+
+// runtimeErrorRegistry replaces the k8s error handlers exactly once per
+// process so that they do not intercept our log streams. Errors are then fanned
+// out to all registered handlers, which is usually one per booted controller.
+// As long as no handler is registered, the original k8s error handlers are
+// used.
+type runtimeErrorRegistry struct {
+	mutex    sync.Mutex
+	once     sync.Once
+	fallback []utilruntime.ErrorHandler
+	handlers map[int]utilruntime.ErrorHandler
+	nextID   int
+}
+
+// Register adds the given handler to the registry and returns a function to
+// remove it again.
+func (r *runtimeErrorRegistry) Register(h utilruntime.ErrorHandler) func() {
+	r.once.Do(func() {
+		r.fallback = utilruntime.ErrorHandlers
+		utilruntime.ErrorHandlers = []utilruntime.ErrorHandler{
+			r.handle,
+		}
+	})
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	id := r.nextID
+	r.nextID++
+	r.handlers[id] = h
+
+	return func() {
+		r.mutex.Lock()
+		defer r.mutex.Unlock()
+
+		delete(r.handlers, id)
+	}
+}
+
+func (r *runtimeErrorRegistry) handle(ctx context.Context, err error, msg string, keysAndValues ...interface{}) {
+	// When we see a port forwarding error we ignore it because we cannot do
+	// anything about it. Errors like we check here would have to be dealt
+	// with in the third party tools we use. The port forwarding in general
+	// is broken by design which will go away with Helm 3, soon TM.
+	if IsPortforward(err) {
+		return
+	}
+
+	thirdPartyErrors.Inc()
+
+	r.mutex.Lock()
+	handlers := make([]utilruntime.ErrorHandler, 0, len(r.handlers))
+	for _, h := range r.handlers {
+		handlers = append(handlers, h)
+	}
+	if len(handlers) == 0 {
+		handlers = r.fallback
+	}
+	r.mutex.Unlock()
+
+	for _, h := range handlers {
+		h(ctx, err, msg, keysAndValues...)
+	}
+}
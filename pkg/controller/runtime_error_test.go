@@ -0,0 +1,53 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+)
+
+func Test_runtimeErrorRegistry_fan_out(t *testing.T) {
+	var a, b int64
+
+	unregisterA := runtimeErrors.Register(func(context.Context, error, string, ...interface{}) {
+		atomic.AddInt64(&a, 1)
+	})
+	unregisterB := runtimeErrors.Register(func(context.Context, error, string, ...interface{}) {
+		atomic.AddInt64(&b, 1)
+	})
+
+	utilruntime.HandleError(errors.New("test error"))
+
+	unregisterA()
+
+	utilruntime.HandleError(errors.New("test error"))
+
+	unregisterB()
+
+	utilruntime.HandleError(errors.New("test error"))
+
+	if atomic.LoadInt64(&a) != 1 {
+		t.Fatalf("expected %d errors got %d", 1, a)
+	}
+	if atomic.LoadInt64(&b) != 2 {
+		t.Fatalf("expected %d errors got %d", 2, b)
+	}
+}
+
+func Test_runtimeErrorRegistry_ignores_portforward(t *testing.T) {
+	var a int64
+
+	unregister := runtimeErrors.Register(func(context.Context, error, string, ...interface{}) {
+		atomic.AddInt64(&a, 1)
+	})
+	defer unregister()
+
+	utilruntime.HandleError(portForwardError)
+
+	if atomic.LoadInt64(&a) != 0 {
+		t.Fatalf("expected %d errors got %d", 0, a)
+	}
+}
@@ -0,0 +1,121 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/giantswarm/microerror"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/operatorkit/v7/pkg/conditions"
+)
+
+const (
+	// ReasonReconciled is the reason of the Ready condition written by the
+	// controller in case the reconciliation succeeded.
+	ReasonReconciled = "Reconciled"
+	// ReasonReconciliationFailed is the reason of the Ready condition written
+	// by the controller in case the reconciliation failed with an error not
+	// specifying any microerror kind.
+	ReasonReconciliationFailed = "ReconciliationFailed"
+)
+
+// StatusObject is implemented by runtime objects whose status is written by
+// the controller when Config.ReadyCondition is enabled.
+type StatusObject interface {
+	client.Object
+	conditions.Setter
+	SetObservedGeneration(generation int64)
+}
+
+// resourceError annotates errors returned by handlers with the name of the
+// failing handler.
+type resourceError struct {
+	resource string
+	err      error
+}
+
+func (e *resourceError) Error() string {
+	return e.err.Error()
+}
+
+func (e *resourceError) Unwrap() error {
+	return e.err
+}
+
+// newReadyCondition returns the Ready condition reflecting the outcome of a
+// reconciliation. Failed reconciliations carry the microerror kind of the
+// given error as reason and the name of the failing handler in the message.
+func newReadyCondition(err error) metav1.Condition {
+	if err == nil {
+		return metav1.Condition{
+			Type:   conditions.Ready,
+			Status: metav1.ConditionTrue,
+			Reason: ReasonReconciled,
+		}
+	}
+
+	reason := ReasonReconciliationFailed
+	message := err.Error()
+	{
+		var merr *microerror.Error
+		if errors.As(err, &merr) {
+			if merr.Kind != "" {
+				reason = strings.ToUpper(merr.Kind[:1]) + merr.Kind[1:]
+			}
+			if merr.Desc != "" {
+				message = merr.Desc
+			}
+		}
+	}
+
+	var rerr *resourceError
+	if errors.As(err, &rerr) {
+		message = fmt.Sprintf("Resource %s failed: %s", rerr.resource, message)
+	}
+
+	return metav1.Condition{
+		Type:    conditions.Ready,
+		Status:  metav1.ConditionFalse,
+		Reason:  reason,
+		Message: message,
+	}
+}
+
+// patchStatus writes the observed generation and the Ready condition
+// reflecting the outcome of the reconciliation to the status subresource of
+// the given runtime object. Runtime objects which do not exist anymore, e.g.
+// after their finalizers were removed, are ignored.
+func (c *Controller) patchStatus(ctx context.Context, obj interface{}, reconcileErr error) error {
+	o, ok := obj.(StatusObject)
+	if !ok {
+		return microerror.Maskf(wrongTypeError, "expected '%T', got '%T'", o, obj)
+	}
+
+	ready := newReadyCondition(reconcileErr)
+
+	mutate := func(latest client.Object) error {
+		s, ok := latest.(StatusObject)
+		if !ok {
+			return microerror.Maskf(wrongTypeError, "expected '%T', got '%T'", s, latest)
+		}
+
+		s.SetObservedGeneration(s.GetGeneration())
+		conditions.Set(s, ready)
+
+		return nil
+	}
+
+	err := c.statusPatcher.Patch(ctx, o, mutate)
+	if apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
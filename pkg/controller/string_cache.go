@@ -6,6 +6,8 @@ import (
 	gocache "github.com/patrickmn/go-cache"
 )
 
+// stringCache is safe for concurrent use since the underlying cache
+// synchronizes all access internally.
 type stringCache struct {
 	underlying *gocache.Cache
 }
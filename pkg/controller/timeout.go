@@ -0,0 +1,38 @@
+package controller
+
+import (
+	"context"
+	"errors"
+
+	"github.com/giantswarm/microerror"
+)
+
+// newResourceCtx returns the context a single resource or handler is executed
+// with, which is canceled once the configured resource timeout is exceeded,
+// if any.
+func (c *Controller) newResourceCtx(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.resourceTimeout == 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, c.resourceTimeout)
+}
+
+// checkTimeout returns a timeoutError in case the given resource failed due to
+// a timeout. This is the case when the returned error is caused by an exceeded
+// deadline or when the given context, which the resource was executed with,
+// exceeded its deadline while the resource was running, be it the resource or
+// the reconciliation timeout. Resources succeeding despite an exceeded deadline
+// are not considered timed out. Otherwise the given error is returned as is.
+func (c *Controller) checkTimeout(ctx context.Context, event string, resource string, err error) error {
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, context.DeadlineExceeded) && !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return err
+	}
+
+	resourceTimeoutCounter.WithLabelValues(c.name, event, resource).Inc()
+
+	return microerror.Maskf(timeoutError, "resource %#q exceeded its deadline", resource)
+}
@@ -0,0 +1,71 @@
+package controller
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Watch describes a secondary runtime object type whose changes trigger the
+// reconciliation of the primary runtime objects the controller manages.
+type Watch struct {
+	// MapFunc maps a changed secondary runtime object to the requests of the
+	// primary runtime objects which have to be reconciled. See MapByLabel and
+	// MapByAnnotation for common implementations.
+	MapFunc handler.MapFunc
+	// Object is an initialized pointer of the secondary runtime object type to
+	// watch, e.g. new(corev1.ConfigMap).
+	Object client.Object
+}
+
+func (w Watch) eventHandler() handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(w.MapFunc)
+}
+
+// MapByAnnotation returns a handler.MapFunc which maps secondary runtime
+// objects to the primary runtime object named by the value of the annotation
+// nameKey. The namespace is taken from the value of the annotation
+// namespaceKey. In case namespaceKey is empty or the annotation is not set, the
+// namespace of the secondary runtime object is used.
+func MapByAnnotation(nameKey, namespaceKey string) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		return mapByKeys(obj, obj.GetAnnotations(), nameKey, namespaceKey)
+	}
+}
+
+// MapByLabel returns a handler.MapFunc which maps secondary runtime objects to
+// the primary runtime object named by the value of the label nameKey. The
+// namespace is taken from the value of the label namespaceKey. In case
+// namespaceKey is empty or the label is not set, the namespace of the secondary
+// runtime object is used.
+func MapByLabel(nameKey, namespaceKey string) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		return mapByKeys(obj, obj.GetLabels(), nameKey, namespaceKey)
+	}
+}
+
+func mapByKeys(obj client.Object, m map[string]string, nameKey, namespaceKey string) []reconcile.Request {
+	name := m[nameKey]
+	if name == "" {
+		return nil
+	}
+
+	namespace := obj.GetNamespace()
+	if namespaceKey != "" && m[namespaceKey] != "" {
+		namespace = m[namespaceKey]
+	}
+
+	requests := []reconcile.Request{
+		{
+			NamespacedName: types.NamespacedName{
+				Name:      name,
+				Namespace: namespace,
+			},
+		},
+	}
+
+	return requests
+}
@@ -0,0 +1,108 @@
+package controller
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func Test_MapFunc(t *testing.T) {
+	testCases := []struct {
+		name             string
+		mapFunc          handler.MapFunc
+		object           *corev1.ConfigMap
+		expectedRequests []reconcile.Request
+	}{
+		{
+			name:    "case 0: label not set",
+			mapFunc: MapByLabel("example.giantswarm.io/name", ""),
+			object: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cm",
+					Namespace: "default",
+				},
+			},
+			expectedRequests: nil,
+		},
+		{
+			name:    "case 1: label set, namespace of object",
+			mapFunc: MapByLabel("example.giantswarm.io/name", "example.giantswarm.io/namespace"),
+			object: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cm",
+					Namespace: "default",
+					Labels: map[string]string{
+						"example.giantswarm.io/name": "foo",
+					},
+				},
+			},
+			expectedRequests: []reconcile.Request{
+				{NamespacedName: types.NamespacedName{Name: "foo", Namespace: "default"}},
+			},
+		},
+		{
+			name:    "case 2: label set, namespace from label",
+			mapFunc: MapByLabel("example.giantswarm.io/name", "example.giantswarm.io/namespace"),
+			object: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cm",
+					Namespace: "default",
+					Labels: map[string]string{
+						"example.giantswarm.io/name":      "foo",
+						"example.giantswarm.io/namespace": "bar",
+					},
+				},
+			},
+			expectedRequests: []reconcile.Request{
+				{NamespacedName: types.NamespacedName{Name: "foo", Namespace: "bar"}},
+			},
+		},
+		{
+			name:    "case 3: annotation set, namespace from annotation",
+			mapFunc: MapByAnnotation("example.giantswarm.io/name", "example.giantswarm.io/namespace"),
+			object: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cm",
+					Namespace: "default",
+					Annotations: map[string]string{
+						"example.giantswarm.io/name":      "foo",
+						"example.giantswarm.io/namespace": "bar",
+					},
+				},
+			},
+			expectedRequests: []reconcile.Request{
+				{NamespacedName: types.NamespacedName{Name: "foo", Namespace: "bar"}},
+			},
+		},
+		{
+			name:    "case 4: annotation mapping ignores labels",
+			mapFunc: MapByAnnotation("example.giantswarm.io/name", ""),
+			object: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cm",
+					Namespace: "default",
+					Labels: map[string]string{
+						"example.giantswarm.io/name": "foo",
+					},
+				},
+			},
+			expectedRequests: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			requests := tc.mapFunc(context.Background(), tc.object)
+
+			if !reflect.DeepEqual(requests, tc.expectedRequests) {
+				t.Fatalf("expected %#v got %#v", tc.expectedRequests, requests)
+			}
+		})
+	}
+}
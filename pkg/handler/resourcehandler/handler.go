@@ -0,0 +1,64 @@
+// Package resourcehandler bridges resource.Interface implementations to
+// handler.Interface so that resources and handlers can be executed within the
+// same chain.
+package resourcehandler
+
+import (
+	"context"
+
+	"github.com/giantswarm/microerror"
+
+	"github.com/giantswarm/operatorkit/v7/pkg/handler"
+	"github.com/giantswarm/operatorkit/v7/pkg/resource"
+)
+
+type Config struct {
+	Resource resource.Interface
+}
+
+// Handler executes the wrapped resource. Control flow is still managed by the
+// resource using the controller context primitives, which is why Handler
+// always returns a nil handler.Response.
+type Handler struct {
+	resource resource.Interface
+}
+
+func New(config Config) (*Handler, error) {
+	if config.Resource == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Resource must not be empty", config)
+	}
+
+	h := &Handler{
+		resource: config.Resource,
+	}
+
+	return h, nil
+}
+
+func (h *Handler) EnsureCreated(ctx context.Context, req handler.Request) (*handler.Response, error) {
+	err := h.resource.EnsureCreated(ctx, req.Obj)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return nil, nil
+}
+
+func (h *Handler) EnsureDeleted(ctx context.Context, req handler.Request) (*handler.Response, error) {
+	err := h.resource.EnsureDeleted(ctx, req.Obj)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return nil, nil
+}
+
+// Name returns the name of the wrapped resource.
+func (h *Handler) Name() string {
+	return h.resource.Name()
+}
+
+// Resource returns the wrapped resource.
+func (h *Handler) Resource() resource.Interface {
+	return h.resource
+}
@@ -0,0 +1,12 @@
+package resourcehandler
+
+import (
+	"testing"
+
+	"github.com/giantswarm/operatorkit/v7/pkg/handler"
+)
+
+func Test_Handler_Interface(t *testing.T) {
+	// This won't compile if *Handler doesn't implement handler.Interface.
+	var _ handler.Interface = &Handler{}
+}
@@ -1,12 +1,36 @@
 package handler
 
-import "context"
+import (
+	"context"
+	"time"
 
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Request is passed to each Handler of the chain reconciling a runtime object.
 type Request struct {
+	// Obj is the runtime object being reconciled.
 	Obj interface{}
 }
 
+// Response carries the outcome of a single Handler execution. Returning a nil
+// Response is equivalent to returning an empty one.
 type Response struct {
+	// KeepFinalizers requests the finalizers of the reconciled runtime object to
+	// be kept on deletion so that the delete event is replayed. This is
+	// equivalent to calling finalizerskeptcontext.SetKept.
+	KeepFinalizers bool
+	// RequeueAfter requests the reconciled runtime object to be reconciled again
+	// after the given delay. In case multiple Handlers request a delay, the
+	// shortest one wins. This is equivalent to calling
+	// requeueaftercontext.SetRequeueAfter.
+	RequeueAfter time.Duration
+	// StatusPatch is applied to the status subresource of the reconciled
+	// runtime object right after the Handler returned. The patch is computed
+	// against the runtime object in Request.Obj, which the Handler is expected
+	// to have modified accordingly, e.g. using client.MergeFrom with a copy of
+	// the original runtime object.
+	StatusPatch client.Patch
 }
 
 // Interface defines the building blocks of an operator's reconciliation logic.
@@ -0,0 +1,83 @@
+package crud
+
+import (
+	"context"
+	"unicode/utf8"
+
+	corev1 "k8s.io/api/core/v1"
+	pkgruntime "k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/giantswarm/operatorkit/v7/pkg/controller/context/updateallowedcontext"
+)
+
+// maxEventMessageLength is the length after which differences emitted as
+// Kubernetes events are truncated.
+const maxEventMessageLength = 1024
+
+// diff renders and logs the difference between the current and the desired
+// state in case the given patch carries a Differ and a non-empty update change.
+// The rendered difference is returned so that it can be emitted as event once
+// the update change is actually applied. Failing to render the difference does
+// not fail the reconciliation.
+func (r *Resource) diff(ctx context.Context, patch *Patch, currentState, desiredState interface{}) string {
+	differ, ok := patch.getDiffer()
+	if !ok {
+		return ""
+	}
+	updateChange, ok := patch.getUpdateChange()
+	if !ok || isEmptyChange(updateChange) {
+		return ""
+	}
+	if r.updateGated && !updateallowedcontext.IsUpdateAllowed(ctx) {
+		return ""
+	}
+
+	d, err := differ.Diff(currentState, desiredState)
+	if err != nil {
+		r.logger.Errorf(ctx, err, "failed to render difference between current and desired state")
+		return ""
+	}
+	if d == "" {
+		return ""
+	}
+
+	r.logger.Debugf(ctx, "computed difference between current and desired state\n%s", d)
+
+	return d
+}
+
+// emitDiff emits the given difference as Kubernetes event on the reconciled
+// runtime object, if an event recorder is configured. It is only called right
+// before the update change is applied, so that canceled reconciliations and
+// dry-runs do not emit events for changes never being applied.
+func (r *Resource) emitDiff(obj interface{}, d string) {
+	if d == "" || r.eventRecorder == nil {
+		return
+	}
+
+	o, ok := obj.(pkgruntime.Object)
+	if !ok {
+		return
+	}
+
+	m := r.Name() + ": " + d
+	if len(m) > maxEventMessageLength {
+		m = truncate(m, maxEventMessageLength-3) + "..."
+	}
+
+	r.eventRecorder.Event(o, corev1.EventTypeNormal, "Updating", m)
+}
+
+// truncate returns the longest prefix of s not exceeding the given number of
+// bytes without splitting any UTF-8 encoded rune.
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+
+	return s[:n]
+}
@@ -0,0 +1,466 @@
+package crud
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/giantswarm/microerror"
+	"github.com/pmezard/go-difflib/difflib"
+	"k8s.io/apimachinery/pkg/util/jsonmergepatch"
+)
+
+type DiffFormat string
+
+const (
+	// DiffFormatJSON renders differences as JSON merge patches. In case the
+	// states are lists of Kubernetes objects, merge patches are rendered per
+	// object keyed by namespace and name.
+	DiffFormatJSON DiffFormat = "json"
+	// DiffFormatUnified renders differences as unified diff of the JSON
+	// representation of the states.
+	DiffFormatUnified DiffFormat = "unified"
+)
+
+const (
+	// RedactedValue replaces the values of redacted fields.
+	RedactedValue = "<redacted>"
+	// RedactedChangedValue replaces the desired values of redacted fields
+	// which differ from their current values, so that changes are still
+	// visible without rendering any value.
+	RedactedChangedValue = "<redacted: changed>"
+)
+
+// LastAppliedConfigurationField is the field path of the annotation kubectl
+// stores the last applied object in, including all of its data.
+const LastAppliedConfigurationField = `metadata.annotations.kubectl\.kubernetes\.io/last-applied-configuration`
+
+// DefaultIgnoredFields is the list of field paths ignored by ObjectDiffer when
+// ObjectDifferConfig.IgnoredFields is nil. These fields are managed by the
+// Kubernetes API server or kubectl and never part of the desired state.
+var DefaultIgnoredFields = []string{
+	LastAppliedConfigurationField,
+	"metadata.creationTimestamp",
+	"metadata.generation",
+	"metadata.managedFields",
+	"metadata.resourceVersion",
+	"metadata.uid",
+}
+
+type ObjectDifferConfig struct {
+	// Format is the format differences are rendered in. Defaults to
+	// DiffFormatUnified.
+	Format DiffFormat
+	// IgnoredFields is the list of dot separated field paths, e.g.
+	// "metadata.uid", which are not taken into account. Field paths are
+	// relative to the objects in case the states are lists. Dots within keys,
+	// e.g. of annotations, are escaped with a backslash. Defaults to
+	// DefaultIgnoredFields.
+	IgnoredFields []string
+	// RedactedFields is the list of dot separated field paths, e.g. "data",
+	// whose values must not be rendered. The values are replaced by
+	// RedactedValue, or RedactedChangedValue for desired values differing from
+	// their current values. In case the field is a map, like the data of a
+	// Secret, the values of each key are replaced individually.
+	RedactedFields []string
+}
+
+// ObjectDiffer implements Differ for any state which can be marshalled to
+// JSON, e.g. Kubernetes objects or lists of them.
+type ObjectDiffer struct {
+	format         DiffFormat
+	ignoredFields  [][]string
+	redactedFields [][]string
+}
+
+func NewObjectDiffer(config ObjectDifferConfig) (*ObjectDiffer, error) {
+	if config.Format == "" {
+		config.Format = DiffFormatUnified
+	}
+	if config.Format != DiffFormatJSON && config.Format != DiffFormatUnified {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Format must be one of %#q or %#q", config, DiffFormatJSON, DiffFormatUnified)
+	}
+	if config.IgnoredFields == nil {
+		config.IgnoredFields = DefaultIgnoredFields
+	}
+
+	d := &ObjectDiffer{
+		format:         config.Format,
+		ignoredFields:  splitFieldPaths(config.IgnoredFields),
+		redactedFields: splitFieldPaths(config.RedactedFields),
+	}
+
+	return d, nil
+}
+
+// Diff implements Differ.
+func (d *ObjectDiffer) Diff(currentState, desiredState interface{}) (string, error) {
+	current, err := d.normalize(currentState)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+	desired, err := d.normalize(desiredState)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	for _, f := range d.redactedFields {
+		redactField(current, desired, f)
+	}
+
+	switch d.format {
+	case DiffFormatJSON:
+		s, err := jsonDiff(current, desired)
+		if err != nil {
+			return "", microerror.Mask(err)
+		}
+
+		return s, nil
+	default:
+		s, err := unifiedDiff(current, desired)
+		if err != nil {
+			return "", microerror.Mask(err)
+		}
+
+		return s, nil
+	}
+}
+
+// normalize converts the given state into its generic JSON representation and
+// removes the ignored fields.
+func (d *ObjectDiffer) normalize(state interface{}) (interface{}, error) {
+	b, err := json.Marshal(state)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	var v interface{}
+	err = json.Unmarshal(b, &v)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	for _, f := range d.ignoredFields {
+		deleteField(v, f)
+	}
+	return v, nil
+}
+
+// jsonDiff renders the difference between the given generic JSON values as
+// JSON merge patch. Lists are diffed per object, keyed by namespace and name.
+func jsonDiff(current, desired interface{}) (string, error) {
+	currentList, currentIsList := current.([]interface{})
+	desiredList, desiredIsList := desired.([]interface{})
+
+	if !currentIsList || !desiredIsList {
+		p, err := mergePatch(current, desired)
+		if err != nil {
+			return "", microerror.Mask(err)
+		}
+		if p == nil {
+			return "", nil
+		}
+
+		s, err := withoutHTMLEscaping(p)
+		if err != nil {
+			return "", microerror.Mask(err)
+		}
+
+		return s, nil
+	}
+
+	currentObjects := map[string]interface{}{}
+	for _, o := range currentList {
+		currentObjects[objectKey(o)] = o
+	}
+	desiredObjects := map[string]interface{}{}
+	for _, o := range desiredList {
+		desiredObjects[objectKey(o)] = o
+	}
+
+	patches := map[string]json.RawMessage{}
+	for k, c := range currentObjects {
+		d, ok := desiredObjects[k]
+		if !ok {
+			patches[k] = json.RawMessage("null")
+			continue
+		}
+
+		p, err := mergePatch(c, d)
+		if err != nil {
+			return "", microerror.Mask(err)
+		}
+		if p != nil {
+			patches[k] = p
+		}
+	}
+	for k, d := range desiredObjects {
+		if _, ok := currentObjects[k]; ok {
+			continue
+		}
+
+		p, err := json.Marshal(d)
+		if err != nil {
+			return "", microerror.Mask(err)
+		}
+		patches[k] = p
+	}
+
+	if len(patches) == 0 {
+		return "", nil
+	}
+
+	b, err := json.Marshal(patches)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	s, err := withoutHTMLEscaping(b)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	return s, nil
+}
+
+// mergePatch returns the JSON merge patch turning current into desired. It
+// returns nil in case there is no difference.
+func mergePatch(current, desired interface{}) ([]byte, error) {
+	c, err := json.Marshal(current)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+	d, err := json.Marshal(desired)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	_, currentIsObject := current.(map[string]interface{})
+	_, desiredIsObject := desired.(map[string]interface{})
+	if !currentIsObject || !desiredIsObject {
+		if string(c) == string(d) {
+			return nil, nil
+		}
+
+		return d, nil
+	}
+
+	p, err := jsonmergepatch.CreateThreeWayJSONMergePatch(c, d, c)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+	if string(p) == "{}" {
+		return nil, nil
+	}
+
+	return p, nil
+}
+
+func objectKey(v interface{}) string {
+	m, _ := v.(map[string]interface{})
+	meta, _ := m["metadata"].(map[string]interface{})
+	name, _ := meta["name"].(string)
+	namespace, _ := meta["namespace"].(string)
+
+	if namespace == "" {
+		return name
+	}
+
+	return namespace + "/" + name
+}
+
+// redactField replaces the values of the field at the given path within the
+// generic JSON values current and desired. Lists are paired by namespace and
+// name of their objects, so that changed values can be marked as such.
+func redactField(current, desired interface{}, path []string) {
+	currentList, currentIsList := current.([]interface{})
+	desiredList, desiredIsList := desired.([]interface{})
+	if currentIsList || desiredIsList {
+		currentObjects := map[string]interface{}{}
+		for _, o := range currentList {
+			currentObjects[objectKey(o)] = o
+		}
+		desiredObjects := map[string]interface{}{}
+		for _, o := range desiredList {
+			desiredObjects[objectKey(o)] = o
+		}
+
+		for k, o := range currentObjects {
+			redactField(o, desiredObjects[k], path)
+		}
+		for k, o := range desiredObjects {
+			if _, ok := currentObjects[k]; !ok {
+				redactField(nil, o, path)
+			}
+		}
+
+		return
+	}
+
+	currentMap, _ := current.(map[string]interface{})
+	desiredMap, _ := desired.(map[string]interface{})
+
+	key := path[0]
+	if len(path) > 1 {
+		redactField(currentMap[key], desiredMap[key], path[1:])
+		return
+	}
+
+	currentValue, currentOK := currentMap[key]
+	desiredValue, desiredOK := desiredMap[key]
+
+	currentValues, currentIsMap := currentValue.(map[string]interface{})
+	desiredValues, desiredIsMap := desiredValue.(map[string]interface{})
+	if (currentIsMap || !currentOK) && (desiredIsMap || !desiredOK) && (currentIsMap || desiredIsMap) {
+		for k, v := range desiredValues {
+			desiredValues[k] = redactedValue(currentValues, k, v)
+		}
+		for k := range currentValues {
+			currentValues[k] = RedactedValue
+		}
+
+		return
+	}
+
+	if desiredOK {
+		desiredMap[key] = redactedValue(currentMap, key, desiredValue)
+	}
+	if currentOK {
+		currentMap[key] = RedactedValue
+	}
+}
+
+// redactedValue returns the marker replacing the given desired value of the
+// given key depending on whether it differs from the current value.
+func redactedValue(current map[string]interface{}, key string, desired interface{}) string {
+	c, ok := current[key]
+	if !ok || !reflect.DeepEqual(c, desired) {
+		return RedactedChangedValue
+	}
+
+	return RedactedValue
+}
+
+// splitFieldPaths splits the given dot separated field paths. Dots escaped
+// with a backslash are retained within keys.
+func splitFieldPaths(paths []string) [][]string {
+	var split [][]string
+	for _, p := range paths {
+		if p == "" {
+			continue
+		}
+
+		var keys []string
+		var key strings.Builder
+		for i := 0; i < len(p); i++ {
+			switch {
+			case p[i] == '\\' && i+1 < len(p) && p[i+1] == '.':
+				key.WriteByte('.')
+				i++
+			case p[i] == '.':
+				keys = append(keys, key.String())
+				key.Reset()
+			default:
+				key.WriteByte(p[i])
+			}
+		}
+		keys = append(keys, key.String())
+
+		split = append(split, keys)
+	}
+
+	return split
+}
+
+// unifiedDiff renders the difference between the given generic JSON values as
+// unified diff of their indented JSON representations.
+func unifiedDiff(current, desired interface{}) (string, error) {
+	c, err := marshalIndent(current)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+	d, err := marshalIndent(desired)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	u := difflib.UnifiedDiff{
+		A:       difflib.SplitLines(c),
+		B:       difflib.SplitLines(d),
+		Context: 3,
+	}
+
+	s, err := difflib.GetUnifiedDiffString(u)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	return s, nil
+}
+
+// marshalIndent renders the given generic JSON value as indented JSON without
+// the escaping of HTML characters applied by encoding/json, so that
+// RedactedValue is rendered readable.
+func marshalIndent(v interface{}) (string, error) {
+	var buf bytes.Buffer
+	e := json.NewEncoder(&buf)
+	e.SetEscapeHTML(false)
+	e.SetIndent("", " ")
+	err := e.Encode(v)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+// withoutHTMLEscaping re-encodes the given JSON without the escaping of HTML
+// characters applied by encoding/json, so that RedactedValue is rendered
+// readable.
+func withoutHTMLEscaping(b []byte) (string, error) {
+	var v interface{}
+	err := json.Unmarshal(b, &v)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	var buf bytes.Buffer
+	e := json.NewEncoder(&buf)
+	e.SetEscapeHTML(false)
+	err = e.Encode(v)
+	if err != nil {
+		return "", microerror.Mask(err)
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+// deleteField removes the field at the given path within v. Lists are
+// traversed transparently so that field paths apply to each of their
+// elements. Maps left empty by removing the field are removed as well, so that
+// e.g. removing the only annotation is not rendered as difference.
+func deleteField(v interface{}, path []string) {
+	switch x := v.(type) {
+	case []interface{}:
+		for _, e := range x {
+			deleteField(e, path)
+		}
+	case map[string]interface{}:
+		next, ok := x[path[0]]
+		if !ok {
+			return
+		}
+		if len(path) == 1 {
+			delete(x, path[0])
+			return
+		}
+
+		deleteField(next, path[1:])
+
+		m, ok := next.(map[string]interface{})
+		if ok && len(m) == 0 {
+			delete(x, path[0])
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package crud
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_ObjectDiffer_Diff(t *testing.T) {
+	testCases := []struct {
+		name               string
+		config             ObjectDifferConfig
+		currentState       interface{}
+		desiredState       interface{}
+		expectedDiff       string
+		expectedContains   []string
+		unexpectedContains []string
+	}{
+		{
+			name:   "case 0: equal states result in an empty diff",
+			config: ObjectDifferConfig{},
+			currentState: []*corev1.ConfigMap{
+				newTestConfigMap("test", "1", map[string]string{"key": "value"}),
+			},
+			desiredState: []*corev1.ConfigMap{
+				newTestConfigMap("test", "", map[string]string{"key": "value"}),
+			},
+			expectedDiff: "",
+		},
+		{
+			name:   "case 1: unified diff shows changed values",
+			config: ObjectDifferConfig{},
+			currentState: []*corev1.ConfigMap{
+				newTestConfigMap("test", "1", map[string]string{"key": "old"}),
+			},
+			desiredState: []*corev1.ConfigMap{
+				newTestConfigMap("test", "", map[string]string{"key": "new"}),
+			},
+			expectedContains: []string{
+				`-   "key": "old"`,
+				`+   "key": "new"`,
+			},
+			unexpectedContains: []string{
+				"resourceVersion",
+			},
+		},
+		{
+			name: "case 2: JSON diff renders merge patches per object",
+			config: ObjectDifferConfig{
+				Format: DiffFormatJSON,
+			},
+			currentState: []*corev1.ConfigMap{
+				newTestConfigMap("test", "1", map[string]string{"key": "old", "same": "value"}),
+				newTestConfigMap("deleted", "1", nil),
+			},
+			desiredState: []*corev1.ConfigMap{
+				newTestConfigMap("test", "", map[string]string{"key": "new", "same": "value"}),
+			},
+			expectedDiff: `{"default/deleted":null,"default/test":{"data":{"key":"new"}}}`,
+		},
+		{
+			name: "case 3: redacted fields are replaced by markers",
+			config: ObjectDifferConfig{
+				RedactedFields: []string{"data"},
+			},
+			currentState: []*corev1.ConfigMap{
+				newTestConfigMap("test", "1", map[string]string{"key": "old-secret"}),
+			},
+			desiredState: []*corev1.ConfigMap{
+				newTestConfigMap("test", "", map[string]string{"key": "new-secret"}),
+			},
+			expectedContains: []string{
+				`-   "key": "<redacted>"`,
+				`+   "key": "<redacted: changed>"`,
+			},
+			unexpectedContains: []string{
+				"old-secret",
+				"new-secret",
+			},
+		},
+		{
+			name: "case 4: redacted fields are not rendered in JSON diffs",
+			config: ObjectDifferConfig{
+				Format:         DiffFormatJSON,
+				RedactedFields: []string{"data"},
+			},
+			currentState: newTestConfigMap("test", "1", map[string]string{"key": "old-secret", "same": "value"}),
+			desiredState: newTestConfigMap("test", "", map[string]string{"key": "new-secret", "same": "value"}),
+			expectedDiff: `{"data":{"key":"<redacted: changed>"}}`,
+		},
+		{
+			name: "case 5: unchanged redacted values are not rendered as difference",
+			config: ObjectDifferConfig{
+				RedactedFields: []string{"data"},
+			},
+			currentState: []*corev1.ConfigMap{
+				newTestConfigMap("test", "1", map[string]string{"key": "secret"}),
+			},
+			desiredState: []*corev1.ConfigMap{
+				newTestConfigMap("test", "", map[string]string{"key": "secret"}),
+			},
+			expectedDiff: "",
+		},
+		{
+			name: "case 6: fields with escaped dots are ignored",
+			config: ObjectDifferConfig{
+				Format: DiffFormatJSON,
+			},
+			currentState: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+					Annotations: map[string]string{
+						"kubectl.kubernetes.io/last-applied-configuration": `{"data":{"key":"secret"}}`,
+					},
+				},
+			},
+			desiredState: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+			},
+			expectedDiff: "",
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			d, err := NewObjectDiffer(tc.config)
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+
+			diff, err := d.Diff(tc.currentState, tc.desiredState)
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+
+			if tc.expectedContains == nil && diff != tc.expectedDiff {
+				t.Fatalf("diff == %q, want %q", diff, tc.expectedDiff)
+			}
+			for _, s := range tc.expectedContains {
+				if !strings.Contains(diff, s) {
+					t.Fatalf("diff == %q, want it to contain %q", diff, s)
+				}
+			}
+			for _, s := range tc.unexpectedContains {
+				if strings.Contains(diff, s) {
+					t.Fatalf("diff == %q, want it not to contain %q", diff, s)
+				}
+			}
+		})
+	}
+}
+
+func Test_NewObjectDiffer_InvalidFormat(t *testing.T) {
+	_, err := NewObjectDiffer(ObjectDifferConfig{Format: "yaml"})
+	if !IsInvalidConfig(err) {
+		t.Fatalf("error == %#v, want invalid config error", err)
+	}
+}
+
+func newTestConfigMap(name, resourceVersion string, data map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       "default",
+			ResourceVersion: resourceVersion,
+		},
+		Data: data,
+	}
+}
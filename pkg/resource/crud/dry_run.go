@@ -0,0 +1,107 @@
+package crud
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/giantswarm/operatorkit/v7/pkg/controller/context/dryruncontext"
+)
+
+// dryRun reports the given change in case the reconciliation is executed in
+// dry-run mode. The change is added to the dry-run plan, logged and counted.
+// dryRun returns true in case the change must not be applied, which is the
+// case in dry-run mode unless the wrapped CRUD resource is able to apply
+// changes without persisting them.
+func (r *Resource) dryRun(ctx context.Context, t patchType, change interface{}) bool {
+	if !dryruncontext.IsDryRun(ctx) {
+		return false
+	}
+
+	if !isEmptyChange(change) {
+		objects := changeObjects(change)
+
+		dryruncontext.AddChange(ctx, dryruncontext.Change{
+			Resource:  r.Name(),
+			Operation: string(t),
+			Objects:   objects,
+			Change:    change,
+		})
+
+		dryRunChangeCounter.WithLabelValues(controllerName(ctx), r.Name(), string(t)).Inc()
+
+		r.logger.LogCtx(ctx,
+			"level", "info",
+			"message", fmt.Sprintf("computed %s change in dry-run mode", t),
+			"operation", string(t),
+			"objects", strings.Join(objects, ","),
+		)
+	}
+
+	d, ok := r.crud.(DryRunner)
+	if ok && d.DryRun() {
+		return false
+	}
+
+	return true
+}
+
+// changeObjects returns the namespaced names of the objects contained in the
+// given change, as far as they can be identified.
+func changeObjects(change interface{}) []string {
+	type object interface {
+		GetName() string
+		GetNamespace() string
+	}
+
+	name := func(v interface{}) (string, bool) {
+		o, ok := v.(object)
+		if !ok || reflect.ValueOf(v).IsZero() {
+			return "", false
+		}
+		if o.GetNamespace() == "" {
+			return o.GetName(), true
+		}
+
+		return o.GetNamespace() + "/" + o.GetName(), true
+	}
+
+	var objects []string
+	{
+		v := reflect.ValueOf(change)
+		if v.Kind() == reflect.Slice {
+			for i := 0; i < v.Len(); i++ {
+				n, ok := name(v.Index(i).Interface())
+				if ok {
+					objects = append(objects, n)
+				}
+			}
+		} else {
+			n, ok := name(change)
+			if ok {
+				objects = append(objects, n)
+			}
+		}
+	}
+
+	return objects
+}
+
+// isEmptyChange checks whether the given change is nil or an empty slice or
+// map, in which case there is nothing to be applied.
+func isEmptyChange(change interface{}) bool {
+	if change == nil {
+		return true
+	}
+
+	v := reflect.ValueOf(change)
+	switch v.Kind() {
+	case reflect.Map, reflect.Slice:
+		return v.Len() == 0
+	case reflect.Ptr, reflect.Interface:
+		return v.IsNil()
+	}
+
+	return false
+}
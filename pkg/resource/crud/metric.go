@@ -0,0 +1,48 @@
+package crud
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/giantswarm/operatorkit/v7/pkg/controller/context/controllernamecontext"
+)
+
+const (
+	PrometheusNamespace = "operatorkit"
+	PrometheusSubsystem = "controller"
+)
+
+var (
+	dryRunChangeCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: PrometheusNamespace,
+			Subsystem: PrometheusSubsystem,
+			Name:      "dry_run_changes_total",
+			Help:      "Number of changes computed but not applied in dry-run mode.",
+		},
+		[]string{"controller", "resource", "operation"},
+	)
+	skippedUpdateCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: PrometheusNamespace,
+			Subsystem: PrometheusSubsystem,
+			Name:      "skipped_updates_total",
+			Help:      "Number of update changes not applied since updates were not allowed.",
+		},
+		[]string{"controller", "resource"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(dryRunChangeCounter)
+	prometheus.MustRegister(skippedUpdateCounter)
+}
+
+// controllerName returns the name of the controller executing the resource,
+// which is used as metric label. It is empty in case the resource is not
+// executed by a controller.
+func controllerName(ctx context.Context) string {
+	name, _ := controllernamecontext.FromContext(ctx)
+	return name
+}
@@ -15,7 +15,8 @@ const (
 // functions respectively. Patch changes are guaranteed to be applied in that
 // order (i.e. create, update, delete).
 type Patch struct {
-	data map[patchType]interface{}
+	data   map[patchType]interface{}
+	differ Differ
 }
 
 func NewPatch() *Patch {
@@ -32,6 +33,12 @@ func (p *Patch) SetDeleteChange(delete interface{}) {
 	p.data[patchDelete] = delete
 }
 
+// SetDiffer sets the Differ used to render the difference between the current
+// and desired state in case the patch contains an update change.
+func (p *Patch) SetDiffer(d Differ) {
+	p.differ = d
+}
+
 func (p *Patch) SetUpdateChange(update interface{}) {
 	p.data[patchUpdate] = update
 }
@@ -46,6 +53,10 @@ func (p *Patch) getDeleteChange() (interface{}, bool) {
 	return delete, ok
 }
 
+func (p *Patch) getDiffer() (Differ, bool) {
+	return p.differ, p.differ != nil
+}
+
 func (p *Patch) getUpdateChange() (interface{}, bool) {
 	update, ok := p.data[patchUpdate]
 	return update, ok
@@ -6,6 +6,7 @@ import (
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
 	"github.com/giantswarm/micrologger/loggermeta"
+	"k8s.io/client-go/tools/record"
 
 	"github.com/giantswarm/operatorkit/v7/pkg/controller/context/reconciliationcanceledcontext"
 	"github.com/giantswarm/operatorkit/v7/pkg/controller/context/resourcecanceledcontext"
@@ -14,8 +15,18 @@ import (
 type ResourceConfig struct {
 	// CRUD is any CRUD Resource implementation wrapped and properly executed by
 	// Resource.
-	CRUD   Interface
-	Logger micrologger.Logger
+	CRUD Interface
+	// EventRecorder is optionally used to emit the differences rendered by the
+	// Differ of update patches as Kubernetes events on the reconciled runtime
+	// object.
+	EventRecorder record.EventRecorder
+	Logger        micrologger.Logger
+
+	// UpdateGated enables the update gating mode. Update changes are then only
+	// applied once a preceding resource signalled updates to be allowed using
+	// updateallowedcontext.SetUpdateAllowed. Skipped update changes are logged
+	// and counted. Create and delete changes are not affected.
+	UpdateGated bool
 }
 
 // Resource wraps Interface and allows the implemention of complex CRUD
@@ -25,8 +36,11 @@ type ResourceConfig struct {
 // Resource steps defined by Interface when wrapped by the metricsresource
 // package.
 type Resource struct {
-	crud   Interface
-	logger micrologger.Logger
+	crud          Interface
+	eventRecorder record.EventRecorder
+	logger        micrologger.Logger
+
+	updateGated bool
 }
 
 func NewResource(config ResourceConfig) (*Resource, error) {
@@ -41,8 +55,11 @@ func NewResource(config ResourceConfig) (*Resource, error) {
 	}
 
 	r := &Resource{
-		crud:   config.CRUD,
-		logger: config.Logger,
+		crud:          config.CRUD,
+		eventRecorder: config.EventRecorder,
+		logger:        config.Logger,
+
+		updateGated: config.UpdateGated,
 	}
 
 	return r, nil
@@ -56,6 +73,18 @@ func (r *Resource) CRUD() Interface {
 	return r.crud
 }
 
+// WithCRUD is not part of the resource.Interface and should not be used. It
+// returns a copy of the resource executing the given CRUD resource instead,
+// and is exposed for wrapping purposes in the wrapper package.
+//
+// NOTE This method should not be used outside operatorkit.
+func (r *Resource) WithCRUD(crud Interface) *Resource {
+	c := *r
+	c.crud = crud
+
+	return &c
+}
+
 func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 	var err error
 
@@ -119,6 +148,11 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 		}
 	}
 
+	var d string
+	if patch != nil {
+		d = r.diff(ctx, patch, currentState, desiredState)
+	}
+
 	{
 		if reconciliationcanceledcontext.IsCanceled(ctx) {
 			return nil
@@ -129,7 +163,7 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 
 		if patch != nil {
 			createState, ok := patch.getCreateChange()
-			if ok {
+			if ok && !r.dryRun(ctx, patchCreate, createState) {
 				meta, ok := loggermeta.FromContext(ctx)
 				if ok {
 					meta.KeyVals["function"] = "ApplyCreateChange"
@@ -153,7 +187,7 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 
 		if patch != nil {
 			deleteState, ok := patch.getDeleteChange()
-			if ok {
+			if ok && !r.dryRun(ctx, patchDelete, deleteState) {
 				meta, ok := loggermeta.FromContext(ctx)
 				if ok {
 					meta.KeyVals["function"] = "ApplyDeleteChange"
@@ -177,12 +211,13 @@ func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
 
 		if patch != nil {
 			updateState, ok := patch.getUpdateChange()
-			if ok {
+			if ok && r.updateAllowed(ctx, updateState) && !r.dryRun(ctx, patchUpdate, updateState) {
 				meta, ok := loggermeta.FromContext(ctx)
 				if ok {
 					meta.KeyVals["function"] = "ApplyUpdateChange"
 					defer delete(meta.KeyVals, "function")
 				}
+				r.emitDiff(obj, d)
 				err := r.crud.ApplyUpdateChange(ctx, obj, updateState)
 				if err != nil {
 					return microerror.Mask(err)
@@ -257,6 +292,11 @@ func (r *Resource) EnsureDeleted(ctx context.Context, obj interface{}) error {
 		}
 	}
 
+	var d string
+	if patch != nil {
+		d = r.diff(ctx, patch, currentState, desiredState)
+	}
+
 	{
 		if reconciliationcanceledcontext.IsCanceled(ctx) {
 			return nil
@@ -267,7 +307,7 @@ func (r *Resource) EnsureDeleted(ctx context.Context, obj interface{}) error {
 
 		if patch != nil {
 			createChange, ok := patch.getCreateChange()
-			if ok {
+			if ok && !r.dryRun(ctx, patchCreate, createChange) {
 				meta, ok := loggermeta.FromContext(ctx)
 				if ok {
 					meta.KeyVals["function"] = "ApplyCreateChange"
@@ -291,7 +331,7 @@ func (r *Resource) EnsureDeleted(ctx context.Context, obj interface{}) error {
 
 		if patch != nil {
 			deleteChange, ok := patch.getDeleteChange()
-			if ok {
+			if ok && !r.dryRun(ctx, patchDelete, deleteChange) {
 				meta, ok := loggermeta.FromContext(ctx)
 				if ok {
 					meta.KeyVals["function"] = "ApplyDeleteChange"
@@ -315,12 +355,13 @@ func (r *Resource) EnsureDeleted(ctx context.Context, obj interface{}) error {
 
 		if patch != nil {
 			updateChange, ok := patch.getUpdateChange()
-			if ok {
+			if ok && r.updateAllowed(ctx, updateChange) && !r.dryRun(ctx, patchUpdate, updateChange) {
 				meta, ok := loggermeta.FromContext(ctx)
 				if ok {
 					meta.KeyVals["function"] = "ApplyUpdateChange"
 					defer delete(meta.KeyVals, "function")
 				}
+				r.emitDiff(obj, d)
 				err := r.crud.ApplyUpdateChange(ctx, obj, updateChange)
 				if err != nil {
 					return microerror.Mask(err)
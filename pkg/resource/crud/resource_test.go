@@ -3,10 +3,21 @@ package crud
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/giantswarm/micrologger/microloggertest"
-
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/giantswarm/operatorkit/v7/pkg/controller/context/controllernamecontext"
+	"github.com/giantswarm/operatorkit/v7/pkg/controller/context/dryruncontext"
+	"github.com/giantswarm/operatorkit/v7/pkg/controller/context/resourcecanceledcontext"
+	"github.com/giantswarm/operatorkit/v7/pkg/controller/context/updateallowedcontext"
 	"github.com/giantswarm/operatorkit/v7/pkg/resource"
 )
 
@@ -176,3 +187,318 @@ func (r *testCRUDResourceOpsPatchDispatch) ApplyUpdateChange(ctx context.Context
 
 	return nil
 }
+
+func Test_Resource_CRUD_DryRun(t *testing.T) {
+	testCases := []struct {
+		name            string
+		dryRun          bool
+		expectedApplied int
+		expectedChanges []dryruncontext.Change
+	}{
+		{
+			name:            "case 0: changes are not applied in dry-run mode",
+			dryRun:          false,
+			expectedApplied: 0,
+			expectedChanges: []dryruncontext.Change{
+				{
+					Resource:  "testCRUDResourceDryRun",
+					Operation: "create",
+					Objects:   []string{"default/test"},
+					Change:    []*metav1.ObjectMeta{{Name: "test", Namespace: "default"}},
+				},
+			},
+		},
+		{
+			name:            "case 1: changes are applied in dry-run mode when supported",
+			dryRun:          true,
+			expectedApplied: 1,
+			expectedChanges: []dryruncontext.Change{
+				{
+					Resource:  "testCRUDResourceDryRun",
+					Operation: "create",
+					Objects:   []string{"default/test"},
+					Change:    []*metav1.ObjectMeta{{Name: "test", Namespace: "default"}},
+				},
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			crud := &testCRUDResourceDryRun{
+				dryRun: tc.dryRun,
+				patch: func() *Patch {
+					p := NewPatch()
+
+					p.SetCreateChange([]*metav1.ObjectMeta{{Name: "test", Namespace: "default"}})
+					p.SetDeleteChange([]*metav1.ObjectMeta{})
+					p.SetUpdateChange(nil)
+
+					return p
+				}(),
+			}
+
+			r, err := NewResource(ResourceConfig{CRUD: crud, Logger: microloggertest.New()})
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+
+			plan := &dryruncontext.Plan{}
+			ctx := dryruncontext.NewContext(context.Background(), plan)
+
+			err = r.EnsureCreated(ctx, nil)
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+
+			if crud.applied != tc.expectedApplied {
+				t.Fatalf("applied == %d, want %d", crud.applied, tc.expectedApplied)
+			}
+			if !reflect.DeepEqual(plan.Changes(), tc.expectedChanges) {
+				t.Fatalf("changes == %#v, want %#v", plan.Changes(), tc.expectedChanges)
+			}
+		})
+	}
+}
+
+type testCRUDResourceDryRun struct {
+	applied int
+	cancel  bool
+	dryRun  bool
+	patch   *Patch
+	updated int
+}
+
+func (r *testCRUDResourceDryRun) GetCurrentState(ctx context.Context, obj interface{}) (interface{}, error) {
+	return nil, nil
+}
+
+func (r *testCRUDResourceDryRun) GetDesiredState(ctx context.Context, obj interface{}) (interface{}, error) {
+	return nil, nil
+}
+
+func (r *testCRUDResourceDryRun) NewUpdatePatch(ctx context.Context, obj, currentState, desiredState interface{}) (*Patch, error) {
+	return r.patch, nil
+}
+
+func (r *testCRUDResourceDryRun) NewDeletePatch(ctx context.Context, obj, currentState, desiredState interface{}) (*Patch, error) {
+	return r.patch, nil
+}
+
+func (r *testCRUDResourceDryRun) Name() string {
+	return "testCRUDResourceDryRun"
+}
+
+func (r *testCRUDResourceDryRun) DryRun() bool {
+	return r.dryRun
+}
+
+func (r *testCRUDResourceDryRun) ApplyCreateChange(ctx context.Context, obj, createState interface{}) error {
+	r.applied++
+	if r.cancel {
+		resourcecanceledcontext.SetCanceled(ctx)
+	}
+	return nil
+}
+
+func (r *testCRUDResourceDryRun) ApplyDeleteChange(ctx context.Context, obj, deleteState interface{}) error {
+	return nil
+}
+
+func (r *testCRUDResourceDryRun) ApplyUpdateChange(ctx context.Context, obj, updateState interface{}) error {
+	r.updated++
+	return nil
+}
+
+func Test_Resource_CRUD_Diff(t *testing.T) {
+	testCases := []struct {
+		name           string
+		ctx            func() context.Context
+		cancel         bool
+		updateChange   interface{}
+		diff           string
+		expectedEvents []string
+	}{
+		{
+			name:           "case 0: no event is emitted for empty update changes",
+			updateChange:   []*metav1.ObjectMeta{},
+			diff:           "-old +new",
+			expectedEvents: nil,
+		},
+		{
+			name:         "case 1: the diff of update changes is emitted as event",
+			updateChange: []*metav1.ObjectMeta{{Name: "test", Namespace: "default"}},
+			diff:         "-old +new",
+			expectedEvents: []string{
+				"Normal Updating testCRUDResourceDryRun: -old +new",
+			},
+		},
+		{
+			name:         "case 2: long diffs are truncated without splitting runes",
+			updateChange: []*metav1.ObjectMeta{{Name: "test", Namespace: "default"}},
+			diff:         strings.Repeat("ü", maxEventMessageLength),
+			expectedEvents: []string{
+				"Normal Updating testCRUDResourceDryRun: " + strings.Repeat("ü", 498) + "...",
+			},
+		},
+		{
+			name: "case 3: no event is emitted in dry-run mode",
+			ctx: func() context.Context {
+				return dryruncontext.NewContext(context.Background(), &dryruncontext.Plan{})
+			},
+			updateChange:   []*metav1.ObjectMeta{{Name: "test", Namespace: "default"}},
+			diff:           "-old +new",
+			expectedEvents: nil,
+		},
+		{
+			name: "case 4: no event is emitted when the resource cancels itself",
+			ctx: func() context.Context {
+				return resourcecanceledcontext.NewContext(context.Background(), make(chan struct{}))
+			},
+			cancel:         true,
+			updateChange:   []*metav1.ObjectMeta{{Name: "test", Namespace: "default"}},
+			diff:           "-old +new",
+			expectedEvents: nil,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			crud := &testCRUDResourceDryRun{
+				cancel: tc.cancel,
+				patch: func() *Patch {
+					p := NewPatch()
+
+					p.SetCreateChange([]*metav1.ObjectMeta{{Name: "created", Namespace: "default"}})
+					p.SetUpdateChange(tc.updateChange)
+					p.SetDiffer(testDiffer(tc.diff))
+
+					return p
+				}(),
+			}
+
+			recorder := record.NewFakeRecorder(10)
+
+			r, err := NewResource(ResourceConfig{CRUD: crud, EventRecorder: recorder, Logger: microloggertest.New()})
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+
+			ctx := context.Background()
+			if tc.ctx != nil {
+				ctx = tc.ctx()
+			}
+
+			err = r.EnsureCreated(ctx, &corev1.ConfigMap{})
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+			close(recorder.Events)
+
+			var events []string
+			for e := range recorder.Events {
+				events = append(events, e)
+			}
+			if !reflect.DeepEqual(events, tc.expectedEvents) {
+				t.Fatalf("events == %#v, want %#v", events, tc.expectedEvents)
+			}
+		})
+	}
+}
+
+type testDiffer string
+
+func (d testDiffer) Diff(currentState, desiredState interface{}) (string, error) {
+	return string(d), nil
+}
+
+func Test_Resource_CRUD_UpdateGated(t *testing.T) {
+	testCases := []struct {
+		name            string
+		updateGated     bool
+		ctx             func() context.Context
+		expectedUpdated int
+	}{
+		{
+			name:        "case 0: updates are applied when not gated",
+			updateGated: false,
+			ctx: func() context.Context {
+				return updateallowedcontext.NewContext(context.Background(), make(chan struct{}))
+			},
+			expectedUpdated: 1,
+		},
+		{
+			name:        "case 1: gated updates are skipped when not allowed",
+			updateGated: true,
+			ctx: func() context.Context {
+				return updateallowedcontext.NewContext(context.Background(), make(chan struct{}))
+			},
+			expectedUpdated: 0,
+		},
+		{
+			name:        "case 2: gated updates are skipped without update allowed channel",
+			updateGated: true,
+			ctx: func() context.Context {
+				return context.Background()
+			},
+			expectedUpdated: 0,
+		},
+		{
+			name:        "case 3: gated updates are applied when allowed",
+			updateGated: true,
+			ctx: func() context.Context {
+				ctx := updateallowedcontext.NewContext(context.Background(), make(chan struct{}))
+				updateallowedcontext.SetUpdateAllowed(ctx)
+				return ctx
+			},
+			expectedUpdated: 1,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			crud := &testCRUDResourceDryRun{
+				patch: func() *Patch {
+					p := NewPatch()
+
+					p.SetCreateChange([]*metav1.ObjectMeta{{Name: "created", Namespace: "default"}})
+					p.SetUpdateChange([]*metav1.ObjectMeta{{Name: "test", Namespace: "default"}})
+
+					return p
+				}(),
+			}
+
+			r, err := NewResource(ResourceConfig{CRUD: crud, Logger: microloggertest.New(), UpdateGated: tc.updateGated})
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+
+			// Each test case uses its own controller name so that the metrics
+			// of the test cases do not interfere.
+			controller := "test-update-gated-" + strconv.Itoa(i)
+
+			err = r.EnsureCreated(controllernamecontext.NewContext(tc.ctx(), controller), nil)
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+
+			if crud.applied != 1 {
+				t.Fatalf("applied == %d, want %d", crud.applied, 1)
+			}
+			if crud.updated != tc.expectedUpdated {
+				t.Fatalf("updated == %d, want %d", crud.updated, tc.expectedUpdated)
+			}
+
+			skipped := testutil.ToFloat64(skippedUpdateCounter.WithLabelValues(controller, r.Name()))
+			if skipped != float64(1-tc.expectedUpdated) {
+				t.Fatalf("skipped == %v, want %d", skipped, 1-tc.expectedUpdated)
+			}
+		})
+	}
+}
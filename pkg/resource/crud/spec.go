@@ -83,3 +83,23 @@ type Interface interface {
 	// already done at this point of the reconciliation loop.
 	ApplyUpdateChange(ctx context.Context, obj, updateChange interface{}) error
 }
+
+// DryRunner can optionally be implemented by CRUD resources which are able to
+// apply changes without persisting them, e.g. using the dry-run mode of the
+// Kubernetes API server. When reconciling in dry-run mode, the Apply*
+// functions of CRUD resources whose DryRun returns true are still called.
+// These CRUD resources must then check dryruncontext.IsDryRun and make sure
+// nothing is persisted. The Apply* functions of all other CRUD resources are
+// skipped in dry-run mode.
+type DryRunner interface {
+	DryRun() bool
+}
+
+// Differ renders the difference between the current and the desired state of
+// a CRUD resource in a human readable way. Differ can optionally be set on a
+// Patch using Patch.SetDiffer. Implementations must make sure that sensitive
+// data is redacted, since the rendered difference is logged and emitted as
+// Kubernetes event.
+type Differ interface {
+	Diff(currentState, desiredState interface{}) (string, error)
+}
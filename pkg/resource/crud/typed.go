@@ -0,0 +1,284 @@
+package crud
+
+import (
+	"context"
+
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	"k8s.io/client-go/tools/record"
+)
+
+// TypedInterface is the type safe counterpart of Interface. Obj is the type
+// of the reconciled runtime object, e.g. *corev1.Namespace, State is the type
+// of the current and desired state, e.g. []*corev1.ConfigMap, and Change is
+// the type of the create, delete and update changes, which is usually the
+// same as State. The semantics of all functions are the same as those of
+// Interface. TypedInterface implementations are executed using
+// NewTypedResource.
+type TypedInterface[Obj, State, Change any] interface {
+	Name() string
+
+	GetCurrentState(ctx context.Context, obj Obj) (State, error)
+	GetDesiredState(ctx context.Context, obj Obj) (State, error)
+
+	NewUpdatePatch(ctx context.Context, obj Obj, currentState, desiredState State) (*TypedPatch[Change], error)
+	NewDeletePatch(ctx context.Context, obj Obj, currentState, desiredState State) (*TypedPatch[Change], error)
+
+	ApplyCreateChange(ctx context.Context, obj Obj, createChange Change) error
+	ApplyDeleteChange(ctx context.Context, obj Obj, deleteChange Change) error
+	ApplyUpdateChange(ctx context.Context, obj Obj, updateChange Change) error
+}
+
+// TypedPatch is the type safe counterpart of Patch returned by
+// TypedInterface implementations.
+type TypedPatch[Change any] struct {
+	patch *Patch
+}
+
+func NewTypedPatch[Change any]() *TypedPatch[Change] {
+	return &TypedPatch[Change]{
+		patch: NewPatch(),
+	}
+}
+
+func (p *TypedPatch[Change]) SetCreateChange(create Change) {
+	p.patch.SetCreateChange(create)
+}
+
+func (p *TypedPatch[Change]) SetDeleteChange(delete Change) {
+	p.patch.SetDeleteChange(delete)
+}
+
+// SetDiffer sets the Differ used to render the difference between the current
+// and desired state in case the patch contains an update change.
+func (p *TypedPatch[Change]) SetDiffer(d Differ) {
+	p.patch.SetDiffer(d)
+}
+
+func (p *TypedPatch[Change]) SetUpdateChange(update Change) {
+	p.patch.SetUpdateChange(update)
+}
+
+type TypedResourceConfig[Obj, State, Change any] struct {
+	// CRUD is any typed CRUD Resource implementation wrapped and properly
+	// executed by Resource.
+	CRUD TypedInterface[Obj, State, Change]
+	// EventRecorder is optionally used to emit the differences rendered by the
+	// Differ of update patches as Kubernetes events on the reconciled runtime
+	// object.
+	EventRecorder record.EventRecorder
+	Logger        micrologger.Logger
+
+	// UpdateGated enables the update gating mode. Update changes are then only
+	// applied once a preceding resource signalled updates to be allowed using
+	// updateallowedcontext.SetUpdateAllowed. Skipped update changes are logged
+	// and counted. Create and delete changes are not affected.
+	UpdateGated bool
+}
+
+// NewTypedResource creates a Resource executing the given TypedInterface
+// implementation. The returned Resource implements resource.Interface and can
+// be wrapped like any other Resource. Runtime objects not matching Obj cause
+// reconciliation to fail with a wrongTypeError.
+func NewTypedResource[Obj, State, Change any](config TypedResourceConfig[Obj, State, Change]) (*Resource, error) {
+	if config.CRUD == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.CRUD must not be empty", config)
+	}
+
+	var r *Resource
+	{
+		c := ResourceConfig{
+			CRUD:          &typedCRUD[Obj, State, Change]{typed: config.CRUD},
+			EventRecorder: config.EventRecorder,
+			Logger:        config.Logger,
+
+			UpdateGated: config.UpdateGated,
+		}
+
+		var err error
+		r, err = NewResource(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	return r, nil
+}
+
+// typedCRUD adapts TypedInterface to Interface.
+type typedCRUD[Obj, State, Change any] struct {
+	typed TypedInterface[Obj, State, Change]
+}
+
+func (c *typedCRUD[Obj, State, Change]) Name() string {
+	return c.typed.Name()
+}
+
+// DryRun implements DryRunner by forwarding to the typed CRUD resource, if
+// it implements DryRunner.
+func (c *typedCRUD[Obj, State, Change]) DryRun() bool {
+	d, ok := c.typed.(DryRunner)
+	if ok {
+		return d.DryRun()
+	}
+
+	return false
+}
+
+func (c *typedCRUD[Obj, State, Change]) GetCurrentState(ctx context.Context, obj interface{}) (interface{}, error) {
+	o, err := toTyped[Obj](obj)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	s, err := c.typed.GetCurrentState(ctx, o)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return s, nil
+}
+
+func (c *typedCRUD[Obj, State, Change]) GetDesiredState(ctx context.Context, obj interface{}) (interface{}, error) {
+	o, err := toTyped[Obj](obj)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	s, err := c.typed.GetDesiredState(ctx, o)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return s, nil
+}
+
+func (c *typedCRUD[Obj, State, Change]) NewUpdatePatch(ctx context.Context, obj, currentState, desiredState interface{}) (*Patch, error) {
+	o, current, desired, err := toTypedPatchInput[Obj, State](obj, currentState, desiredState)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	p, err := c.typed.NewUpdatePatch(ctx, o, current, desired)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return toPatch(p), nil
+}
+
+func (c *typedCRUD[Obj, State, Change]) NewDeletePatch(ctx context.Context, obj, currentState, desiredState interface{}) (*Patch, error) {
+	o, current, desired, err := toTypedPatchInput[Obj, State](obj, currentState, desiredState)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	p, err := c.typed.NewDeletePatch(ctx, o, current, desired)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return toPatch(p), nil
+}
+
+func (c *typedCRUD[Obj, State, Change]) ApplyCreateChange(ctx context.Context, obj, createChange interface{}) error {
+	o, change, err := toTypedApplyInput[Obj, Change](obj, createChange)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	err = c.typed.ApplyCreateChange(ctx, o, change)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func (c *typedCRUD[Obj, State, Change]) ApplyDeleteChange(ctx context.Context, obj, deleteChange interface{}) error {
+	o, change, err := toTypedApplyInput[Obj, Change](obj, deleteChange)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	err = c.typed.ApplyDeleteChange(ctx, o, change)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func (c *typedCRUD[Obj, State, Change]) ApplyUpdateChange(ctx context.Context, obj, updateChange interface{}) error {
+	o, change, err := toTypedApplyInput[Obj, Change](obj, updateChange)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	err = c.typed.ApplyUpdateChange(ctx, o, change)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func toPatch[Change any](p *TypedPatch[Change]) *Patch {
+	if p == nil {
+		return nil
+	}
+
+	return p.patch
+}
+
+// toTyped converts v to T. Untyped nil values are converted to the zero value
+// of T.
+func toTyped[T any](v interface{}) (T, error) {
+	var zero T
+	if v == nil {
+		return zero, nil
+	}
+
+	x, ok := v.(T)
+	if !ok {
+		return zero, microerror.Maskf(wrongTypeError, "expected '%T', got '%T'", zero, v)
+	}
+
+	return x, nil
+}
+
+func toTypedApplyInput[Obj, Change any](obj, change interface{}) (Obj, Change, error) {
+	var zeroObj Obj
+	var zeroChange Change
+
+	o, err := toTyped[Obj](obj)
+	if err != nil {
+		return zeroObj, zeroChange, microerror.Mask(err)
+	}
+	c, err := toTyped[Change](change)
+	if err != nil {
+		return zeroObj, zeroChange, microerror.Mask(err)
+	}
+
+	return o, c, nil
+}
+
+func toTypedPatchInput[Obj, State any](obj, currentState, desiredState interface{}) (Obj, State, State, error) {
+	var zeroObj Obj
+	var zeroState State
+
+	o, err := toTyped[Obj](obj)
+	if err != nil {
+		return zeroObj, zeroState, zeroState, microerror.Mask(err)
+	}
+	current, err := toTyped[State](currentState)
+	if err != nil {
+		return zeroObj, zeroState, zeroState, microerror.Mask(err)
+	}
+	desired, err := toTyped[State](desiredState)
+	if err != nil {
+		return zeroObj, zeroState, zeroState, microerror.Mask(err)
+	}
+
+	return o, current, desired, nil
+}
@@ -0,0 +1,128 @@
+package crud
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/giantswarm/micrologger/microloggertest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/operatorkit/v7/pkg/resource"
+)
+
+func Test_Resource_Typed_Interface(t *testing.T) {
+	var _ Interface = &typedCRUD[*corev1.ConfigMap, []string, []string]{}
+	var _ DryRunner = &typedCRUD[*corev1.ConfigMap, []string, []string]{}
+	var _ resource.Interface = &Resource{}
+}
+
+func Test_Resource_Typed_EnsureCreated(t *testing.T) {
+	testCases := []struct {
+		name            string
+		obj             interface{}
+		expectedApplied []string
+		errorMatcher    func(error) bool
+	}{
+		{
+			name: "case 0: typed changes are applied",
+			obj: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+			},
+			expectedApplied: []string{
+				"create test-a",
+				"delete test-c",
+				"update test-b",
+			},
+			errorMatcher: nil,
+		},
+		{
+			name: "case 1: runtime objects of the wrong type are rejected",
+			obj: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+			},
+			expectedApplied: nil,
+			errorMatcher:    IsWrongType,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			crud := &testTypedCRUD{}
+
+			c := TypedResourceConfig[*corev1.ConfigMap, []string, string]{
+				CRUD:   crud,
+				Logger: microloggertest.New(),
+			}
+
+			r, err := NewTypedResource(c)
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+
+			err = r.EnsureCreated(context.Background(), tc.obj)
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				// correct; carry on
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+
+			if !reflect.DeepEqual(crud.applied, tc.expectedApplied) {
+				t.Fatalf("applied == %#v, want %#v", crud.applied, tc.expectedApplied)
+			}
+		})
+	}
+}
+
+type testTypedCRUD struct {
+	applied []string
+}
+
+func (r *testTypedCRUD) Name() string {
+	return "testTypedCRUD"
+}
+
+func (r *testTypedCRUD) GetCurrentState(ctx context.Context, obj *corev1.ConfigMap) ([]string, error) {
+	return []string{obj.Name + "-b", obj.Name + "-c"}, nil
+}
+
+func (r *testTypedCRUD) GetDesiredState(ctx context.Context, obj *corev1.ConfigMap) ([]string, error) {
+	return []string{obj.Name + "-a", obj.Name + "-b"}, nil
+}
+
+func (r *testTypedCRUD) NewUpdatePatch(ctx context.Context, obj *corev1.ConfigMap, currentState, desiredState []string) (*TypedPatch[string], error) {
+	patch := NewTypedPatch[string]()
+	patch.SetCreateChange(desiredState[0])
+	patch.SetDeleteChange(currentState[1])
+	patch.SetUpdateChange(currentState[0])
+
+	return patch, nil
+}
+
+func (r *testTypedCRUD) NewDeletePatch(ctx context.Context, obj *corev1.ConfigMap, currentState, desiredState []string) (*TypedPatch[string], error) {
+	return nil, nil
+}
+
+func (r *testTypedCRUD) ApplyCreateChange(ctx context.Context, obj *corev1.ConfigMap, createChange string) error {
+	r.applied = append(r.applied, "create "+createChange)
+	return nil
+}
+
+func (r *testTypedCRUD) ApplyDeleteChange(ctx context.Context, obj *corev1.ConfigMap, deleteChange string) error {
+	r.applied = append(r.applied, "delete "+deleteChange)
+	return nil
+}
+
+func (r *testTypedCRUD) ApplyUpdateChange(ctx context.Context, obj *corev1.ConfigMap, updateChange string) error {
+	r.applied = append(r.applied, "update "+updateChange)
+	return nil
+}
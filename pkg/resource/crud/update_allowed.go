@@ -0,0 +1,31 @@
+package crud
+
+import (
+	"context"
+	"strings"
+
+	"github.com/giantswarm/operatorkit/v7/pkg/controller/context/updateallowedcontext"
+)
+
+// updateAllowed checks whether the given update change may be applied. This
+// is always the case unless the update gating mode is enabled. Then update
+// changes are only applied once a preceding resource signalled updates to be
+// allowed using updateallowedcontext.SetUpdateAllowed. Skipped update changes
+// are logged and counted.
+func (r *Resource) updateAllowed(ctx context.Context, change interface{}) bool {
+	if !r.updateGated || updateallowedcontext.IsUpdateAllowed(ctx) {
+		return true
+	}
+
+	if !isEmptyChange(change) {
+		skippedUpdateCounter.WithLabelValues(controllerName(ctx), r.Name()).Inc()
+
+		r.logger.LogCtx(ctx,
+			"level", "info",
+			"message", "skipped update change since updates are not allowed",
+			"objects", strings.Join(changeObjects(change), ","),
+		)
+	}
+
+	return false
+}
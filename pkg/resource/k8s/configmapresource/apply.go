@@ -0,0 +1,59 @@
+package configmapresource
+
+import (
+	"bytes"
+	"maps"
+
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+	corev1apply "k8s.io/client-go/applyconfigurations/core/v1"
+
+	"github.com/giantswarm/operatorkit/v7/pkg/resource/k8s/internal/ownership"
+)
+
+// newConfigMapApplyConfiguration creates the apply configuration of the given
+// desired ConfigMap used with server-side apply. It only contains the fields
+// managed by this resource.
+func newConfigMapApplyConfiguration(desired *corev1.ConfigMap) *corev1apply.ConfigMapApplyConfiguration {
+	a := corev1apply.ConfigMap(desired.Name, desired.Namespace).
+		WithAnnotations(desired.Annotations).
+		WithLabels(desired.Labels).
+		WithBinaryData(desired.BinaryData).
+		WithData(desired.Data)
+
+	if len(desired.OwnerReferences) != 0 {
+		a = a.WithOwnerReferences(ownership.NewOwnerReferenceApplyConfigurations(desired.OwnerReferences)...)
+	}
+
+	return a
+}
+
+// newConfigMapToApply returns the desired ConfigMap in case it has to be
+// applied using server-side apply. It returns nil if the name or namespace
+// doesn't match or if the fields owned by the given field manager in the
+// current ConfigMap don't differ from the desired ConfigMap. Fields owned by
+// other field managers are not taken into account.
+func newConfigMapToApply(current, desired *corev1.ConfigMap, fieldManager string) (*corev1.ConfigMap, error) {
+	if current.Namespace != desired.Namespace {
+		return nil, nil
+	}
+	if current.Name != desired.Name {
+		return nil, nil
+	}
+
+	owned, err := corev1apply.ExtractConfigMap(current, fieldManager)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+	applied := newConfigMapApplyConfiguration(desired)
+
+	if maps.Equal(owned.Annotations, applied.Annotations) &&
+		maps.Equal(owned.Labels, applied.Labels) &&
+		ownership.EqualOwnerReferenceApplyConfigurations(owned.OwnerReferences, applied.OwnerReferences) &&
+		maps.EqualFunc(owned.BinaryData, applied.BinaryData, bytes.Equal) &&
+		maps.Equal(owned.Data, applied.Data) {
+		return nil, nil
+	}
+
+	return desired, nil
+}
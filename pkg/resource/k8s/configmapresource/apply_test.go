@@ -0,0 +1,115 @@
+package configmapresource
+
+import (
+	"strconv"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_newConfigMapToApply(t *testing.T) {
+	current := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+			Labels: map[string]string{
+				"owned-by-someone-else": "true",
+			},
+			ManagedFields: []metav1.ManagedFieldsEntry{
+				{
+					Manager:    "test-operator",
+					Operation:  metav1.ManagedFieldsOperationApply,
+					APIVersion: "v1",
+					FieldsType: "FieldsV1",
+					FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:data":{"f:foo":{}}}`)},
+				},
+				{
+					Manager:    "someone-else",
+					Operation:  metav1.ManagedFieldsOperationApply,
+					APIVersion: "v1",
+					FieldsType: "FieldsV1",
+					FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:data":{"f:bar":{}},"f:metadata":{"f:labels":{"f:owned-by-someone-else":{}}}}`)},
+				},
+			},
+		},
+		Data: map[string]string{
+			"foo": "1",
+			"bar": "2",
+		},
+	}
+
+	testCases := []struct {
+		name            string
+		desired         *corev1.ConfigMap
+		expectedToApply bool
+	}{
+		{
+			name: "case 0: owned fields match, foreign fields are ignored",
+			desired: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Data: map[string]string{
+					"foo": "1",
+				},
+			},
+			expectedToApply: false,
+		},
+		{
+			name: "case 1: owned field differs",
+			desired: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Data: map[string]string{
+					"foo": "2",
+				},
+			},
+			expectedToApply: true,
+		},
+		{
+			name: "case 2: new field desired",
+			desired: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+					Labels: map[string]string{
+						"app": "test",
+					},
+				},
+				Data: map[string]string{
+					"foo": "1",
+				},
+			},
+			expectedToApply: true,
+		},
+		{
+			name: "case 3: different name",
+			desired: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "other",
+					Namespace: "default",
+				},
+			},
+			expectedToApply: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			m, err := newConfigMapToApply(current, tc.desired, "test-operator")
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+
+			if (m != nil) != tc.expectedToApply {
+				t.Fatalf("expected to apply %t got %t", tc.expectedToApply, m != nil)
+			}
+		})
+	}
+}
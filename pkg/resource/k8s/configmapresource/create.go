@@ -17,9 +17,22 @@ func (r *Resource) ApplyCreateChange(ctx context.Context, obj, createChange inte
 	}
 
 	for _, configMap := range configMaps {
+		if r.serverSideApply {
+			r.logger.Debugf(ctx, "applying ConfigMap %#q in namespace %#q", configMap.Name, configMap.Namespace)
+
+			_, err = r.k8sClient.CoreV1().ConfigMaps(configMap.Namespace).Apply(ctx, newConfigMapApplyConfiguration(configMap), r.applyOptions(ctx))
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			r.logger.Debugf(ctx, "applied ConfigMap %#q in namespace %#q", configMap.Name, configMap.Namespace)
+
+			continue
+		}
+
 		r.logger.Debugf(ctx, "creating ConfigMap %#q in namespace %#q", configMap.Name, configMap.Namespace)
 
-		_, err = r.k8sClient.CoreV1().ConfigMaps(configMap.Namespace).Create(ctx, configMap, metav1.CreateOptions{})
+		_, err = r.k8sClient.CoreV1().ConfigMaps(configMap.Namespace).Create(ctx, configMap, metav1.CreateOptions{DryRun: dryRun(ctx)})
 		if apierrors.IsAlreadyExists(err) {
 			r.logger.Debugf(ctx, "already created ConfigMap %#q in namespace %#q", configMap.Name, configMap.Namespace)
 		} else if err != nil {
@@ -4,6 +4,8 @@ import (
 	"reflect"
 
 	corev1 "k8s.io/api/core/v1"
+
+	"github.com/giantswarm/operatorkit/v7/pkg/resource/k8s/internal/ownership"
 )
 
 // newConfigMapToUpdate creates a new instance of ConfigMap ready to be used as an
@@ -22,6 +24,7 @@ func newConfigMapToUpdate(current, desired *corev1.ConfigMap, allowedLabels map[
 
 	merged.Annotations = desired.Annotations
 	merged.Labels = desired.Labels
+	merged.OwnerReferences = ownership.MergeOwnerReferences(current.OwnerReferences, desired.OwnerReferences)
 
 	if allowedLabels != nil {
 		for k, v := range current.Labels {
@@ -0,0 +1,123 @@
+package configmapresource
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/giantswarm/micrologger/microloggertest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func Test_Resource_OwnerReferences(t *testing.T) {
+	testCases := []struct {
+		name            string
+		owner           interface{}
+		expectedDeleted int
+		errorMatcher    func(error) bool
+	}{
+		{
+			name: "case 0: namespaced owner in the same namespace",
+			owner: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "owner", Namespace: "default", UID: "1"},
+			},
+			expectedDeleted: 0,
+		},
+		{
+			name: "case 1: cluster scoped owner",
+			owner: &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{Name: "owner", UID: "2"},
+			},
+			expectedDeleted: 0,
+		},
+		{
+			name: "case 2: namespaced owner in another namespace",
+			owner: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "owner", Namespace: "other", UID: "3"},
+			},
+			errorMatcher: IsInvalidOwner,
+		},
+		{
+			name:         "case 3: owner is not an object",
+			owner:        "owner",
+			errorMatcher: IsWrongTypeError,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			ctx := context.Background()
+
+			var r *Resource
+			{
+				c := Config{
+					K8sClient:   fake.NewClientset(),
+					Logger:      microloggertest.New(),
+					StateGetter: &testStateGetter{},
+
+					Name:            "test",
+					OwnerReferences: true,
+				}
+
+				var err error
+				r, err = New(c)
+				if err != nil {
+					t.Fatalf("error == %#v, want nil", err)
+				}
+			}
+
+			desired, err := r.GetDesiredState(ctx, tc.owner)
+
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				// correct; carry on
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+
+			if tc.errorMatcher != nil {
+				return
+			}
+
+			configMaps, err := toConfigMaps(desired)
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+			if len(configMaps[0].OwnerReferences) != 1 {
+				t.Fatalf("expected 1 owner reference got %d", len(configMaps[0].OwnerReferences))
+			}
+
+			deleted, err := r.newDeleteChangeForDeletePatch(ctx, tc.owner, desired, desired)
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+			if len(deleted) != tc.expectedDeleted {
+				t.Fatalf("expected %d ConfigMaps to delete got %d", tc.expectedDeleted, len(deleted))
+			}
+		})
+	}
+}
+
+type testStateGetter struct{}
+
+func (g *testStateGetter) GetCurrentState(ctx context.Context, obj interface{}) ([]*corev1.ConfigMap, error) {
+	return nil, nil
+}
+
+func (g *testStateGetter) GetDesiredState(ctx context.Context, obj interface{}) ([]*corev1.ConfigMap, error) {
+	configMaps := []*corev1.ConfigMap{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		},
+	}
+
+	return configMaps, nil
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/giantswarm/microerror"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/operatorkit/v7/pkg/resource/k8s/internal/ownership"
 )
 
 func (r *Resource) ApplyUpdateChange(ctx context.Context, obj, updateChange interface{}) error {
@@ -15,9 +17,22 @@ func (r *Resource) ApplyUpdateChange(ctx context.Context, obj, updateChange inte
 	}
 
 	for _, configMap := range configMaps {
+		if r.serverSideApply {
+			r.logger.Debugf(ctx, "applying ConfigMap %#q in namespace %#q", configMap.Name, configMap.Namespace)
+
+			_, err = r.k8sClient.CoreV1().ConfigMaps(configMap.Namespace).Apply(ctx, newConfigMapApplyConfiguration(configMap), r.applyOptions(ctx))
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			r.logger.Debugf(ctx, "applied ConfigMap %#q in namespace %#q", configMap.Name, configMap.Namespace)
+
+			continue
+		}
+
 		r.logger.Debugf(ctx, "updating ConfigMap %#q in namespace %#q", configMap.Name, configMap.Namespace)
 
-		_, err = r.k8sClient.CoreV1().ConfigMaps(configMap.Namespace).Update(ctx, configMap, metav1.UpdateOptions{})
+		_, err = r.k8sClient.CoreV1().ConfigMaps(configMap.Namespace).Update(ctx, configMap, metav1.UpdateOptions{DryRun: dryRun(ctx)})
 		if err != nil {
 			return microerror.Mask(err)
 		}
@@ -44,8 +59,33 @@ func (r *Resource) newUpdateChange(ctx context.Context, obj, currentState, desir
 
 		for _, c := range currentConfigMaps {
 			for _, d := range desiredConfigMaps {
-				m := newConfigMapToUpdate(c, d, r.allowedLabels)
+				if r.ownerReferences {
+					err = ownership.ValidateOwner(c, d)
+					if err != nil {
+						return nil, microerror.Mask(err)
+					}
+				}
+
+				var m *corev1.ConfigMap
+				if r.serverSideApply {
+					m, err = newConfigMapToApply(c, d, r.fieldManager)
+					if err != nil {
+						return nil, microerror.Mask(err)
+					}
+				} else {
+					m = newConfigMapToUpdate(c, d, r.allowedLabels)
+				}
+
 				if m != nil {
+					// Objects are only required to be managed by this
+					// resource in case they are actually updated.
+					if r.managedBy != "" {
+						err = ownership.ValidateManaged(c, r.managedBy, r.name)
+						if err != nil {
+							return nil, microerror.Mask(err)
+						}
+					}
+
 					configMapsToUpdate = append(configMapsToUpdate, m)
 				}
 			}
@@ -0,0 +1,31 @@
+package ownership
+
+import "github.com/giantswarm/microerror"
+
+var invalidOwnerError = &microerror.Error{
+	Kind: "invalidOwnerError",
+}
+
+// IsInvalidOwner asserts invalidOwnerError.
+func IsInvalidOwner(err error) bool {
+	return microerror.Cause(err) == invalidOwnerError
+}
+
+var wrongTypeError = &microerror.Error{
+	Kind: "wrongTypeError",
+}
+
+// IsWrongTypeError asserts wrongTypeError.
+func IsWrongTypeError(err error) bool {
+	return microerror.Cause(err) == wrongTypeError
+}
+
+var unmanagedObjectError = &microerror.Error{
+	Desc: "The operator refused to modify or delete an object which it does not manage.",
+	Kind: "unmanagedObjectError",
+}
+
+// IsUnmanagedObject asserts unmanagedObjectError.
+func IsUnmanagedObject(err error) bool {
+	return microerror.Cause(err) == unmanagedObjectError
+}
@@ -0,0 +1,35 @@
+package ownership
+
+import (
+	"github.com/giantswarm/microerror"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/operatorkit/v7/pkg/resource/k8s/label"
+)
+
+// SetManagedLabels stamps the labels marking the given objects as managed by
+// the given resource.
+func SetManagedLabels[T client.Object](objects []T, managedBy string, resource string) {
+	for _, o := range objects {
+		labels := map[string]string{}
+		for k, v := range o.GetLabels() {
+			labels[k] = v
+		}
+
+		labels[label.ManagedBy] = managedBy
+		labels[label.Resource] = resource
+
+		o.SetLabels(labels)
+	}
+}
+
+// ValidateManaged ensures the given object carries the labels marking it as
+// managed by the given resource, so that objects created by anybody else are
+// never modified or deleted.
+func ValidateManaged(o client.Object, managedBy string, resource string) error {
+	if o.GetLabels()[label.ManagedBy] != managedBy || o.GetLabels()[label.Resource] != resource {
+		return microerror.Maskf(unmanagedObjectError, "%s %#q in namespace %#q is not managed by %#q resource %#q", kind(o), o.GetName(), o.GetNamespace(), managedBy, resource)
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package ownership
+
+import (
+	"strconv"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/operatorkit/v7/pkg/resource/k8s/label"
+)
+
+func Test_ValidateManaged(t *testing.T) {
+	testCases := []struct {
+		name         string
+		labels       map[string]string
+		errorMatcher func(error) bool
+	}{
+		{
+			name: "case 0: objects labelled by the resource are managed",
+			labels: map[string]string{
+				label.ManagedBy: "test-operator",
+				label.Resource:  "test",
+			},
+		},
+		{
+			name:         "case 1: objects without labels are not managed",
+			labels:       nil,
+			errorMatcher: IsUnmanagedObject,
+		},
+		{
+			name: "case 2: objects labelled by another resource are not managed",
+			labels: map[string]string{
+				label.ManagedBy: "test-operator",
+				label.Resource:  "other",
+			},
+			errorMatcher: IsUnmanagedObject,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			o := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default", Labels: tc.labels},
+			}
+
+			err := ValidateManaged(o, "test-operator", "test")
+
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				// correct; carry on
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+
+			SetManagedLabels([]*corev1.ConfigMap{o}, "test-operator", "test")
+
+			err = ValidateManaged(o, "test-operator", "test")
+			if err != nil {
+				t.Fatalf("error == %#v, want nil after setting managed labels", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+// Package ownership implements owner references and ownership labels shared
+// by the resources managing Kubernetes objects.
+package ownership
+
+import (
+	"context"
+	"reflect"
+	"slices"
+
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	metav1apply "k8s.io/client-go/applyconfigurations/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// SetOwnerReferences sets a controller owner reference pointing to the
+// reconciled object on the given objects. It fails if the reconciled object is
+// namespace scoped and lives in another namespace than any of the objects.
+func SetOwnerReferences[T client.Object](obj interface{}, objects []T, scheme *runtime.Scheme) error {
+	owner, err := toOwner(obj)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	for _, o := range objects {
+		err = controllerutil.SetControllerReference(owner, o, scheme)
+		if err != nil {
+			return microerror.Maskf(invalidOwnerError, "%s %#q in namespace %#q: %s", kind(o), o.GetName(), o.GetNamespace(), err)
+		}
+	}
+
+	return nil
+}
+
+// WithoutGarbageCollected returns the objects which are not controlled by the
+// reconciled object and would therefore not be deleted by the Kubernetes
+// garbage collector.
+func WithoutGarbageCollected[T client.Object](ctx context.Context, logger micrologger.Logger, obj interface{}, objects []T) ([]T, error) {
+	owner, err := toOwner(obj)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	var filtered []T
+	for _, o := range objects {
+		if metav1.IsControlledBy(o, owner) {
+			logger.Debugf(ctx, "not deleting %s %#q in namespace %#q since it is garbage collected", kind(o), o.GetName(), o.GetNamespace())
+			continue
+		}
+
+		filtered = append(filtered, o)
+	}
+
+	return filtered, nil
+}
+
+// ValidateOwner ensures the current object is not controlled by another
+// object than the desired object. It does nothing if the name or namespace
+// doesn't match.
+func ValidateOwner(current, desired client.Object) error {
+	if current.GetNamespace() != desired.GetNamespace() {
+		return nil
+	}
+	if current.GetName() != desired.GetName() {
+		return nil
+	}
+
+	c := metav1.GetControllerOfNoCopy(current)
+	d := metav1.GetControllerOfNoCopy(desired)
+	if c != nil && d != nil && c.UID != d.UID {
+		return microerror.Maskf(invalidOwnerError, "%s %#q in namespace %#q is already controlled by %s %#q", kind(current), current.GetName(), current.GetNamespace(), c.Kind, c.Name)
+	}
+
+	return nil
+}
+
+// MergeOwnerReferences adds the desired owner references to the current ones.
+// Owner references are matched by UID.
+func MergeOwnerReferences(current, desired []metav1.OwnerReference) []metav1.OwnerReference {
+	if len(desired) == 0 {
+		return current
+	}
+
+	merged := append([]metav1.OwnerReference{}, current...)
+	for _, d := range desired {
+		var found bool
+		for i, m := range merged {
+			if m.UID == d.UID {
+				merged[i] = d
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			merged = append(merged, d)
+		}
+	}
+
+	return merged
+}
+
+// NewOwnerReferenceApplyConfigurations converts the given owner references
+// for use with server-side apply.
+func NewOwnerReferenceApplyConfigurations(references []metav1.OwnerReference) []*metav1apply.OwnerReferenceApplyConfiguration {
+	var configurations []*metav1apply.OwnerReferenceApplyConfiguration
+	for _, o := range references {
+		c := metav1apply.OwnerReference().
+			WithAPIVersion(o.APIVersion).
+			WithKind(o.Kind).
+			WithName(o.Name).
+			WithUID(o.UID)
+
+		if o.Controller != nil {
+			c = c.WithController(*o.Controller)
+		}
+		if o.BlockOwnerDeletion != nil {
+			c = c.WithBlockOwnerDeletion(*o.BlockOwnerDeletion)
+		}
+
+		configurations = append(configurations, c)
+	}
+
+	return configurations
+}
+
+// EqualOwnerReferenceApplyConfigurations compares the given owner references
+// used with server-side apply treating nil and empty slices as equal.
+func EqualOwnerReferenceApplyConfigurations(a, b []metav1apply.OwnerReferenceApplyConfiguration) bool {
+	return slices.EqualFunc(a, b, func(x, y metav1apply.OwnerReferenceApplyConfiguration) bool {
+		return reflect.DeepEqual(x, y)
+	})
+}
+
+// kind returns the kind of the given object used in log and error messages,
+// which is the name of the type the object points to, e.g. ConfigMap.
+func kind(o client.Object) string {
+	t := reflect.TypeOf(o)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return t.Name()
+}
+
+func toOwner(v interface{}) (client.Object, error) {
+	x, ok := v.(client.Object)
+	if !ok {
+		return nil, microerror.Maskf(wrongTypeError, "expected '%T', got '%T'", x, v)
+	}
+
+	return x, nil
+}
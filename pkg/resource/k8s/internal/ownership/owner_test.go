@@ -0,0 +1,158 @@
+package ownership
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	metav1apply "k8s.io/client-go/applyconfigurations/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func Test_ValidateOwner(t *testing.T) {
+	testCases := []struct {
+		name         string
+		current      *corev1.Secret
+		desired      *corev1.Secret
+		errorMatcher func(error) bool
+	}{
+		{
+			name:    "case 0: objects controlled by the same owner are valid",
+			current: newTestSecret("test", "1"),
+			desired: newTestSecret("test", "1"),
+		},
+		{
+			name:    "case 1: objects without controller are valid",
+			current: newTestSecret("test", ""),
+			desired: newTestSecret("test", "1"),
+		},
+		{
+			name:         "case 2: objects controlled by another owner are invalid",
+			current:      newTestSecret("test", "1"),
+			desired:      newTestSecret("test", "2"),
+			errorMatcher: IsInvalidOwner,
+		},
+		{
+			name:    "case 3: objects with different names are ignored",
+			current: newTestSecret("test", "1"),
+			desired: newTestSecret("other", "2"),
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			err := ValidateOwner(tc.current, tc.desired)
+
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				// correct; carry on
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+		})
+	}
+}
+
+func Test_MergeOwnerReferences(t *testing.T) {
+	current := []metav1.OwnerReference{
+		{Name: "kept", UID: "1"},
+		{Name: "replaced", UID: "2"},
+	}
+	desired := []metav1.OwnerReference{
+		{Name: "replacing", UID: "2", Controller: ptr.To(true)},
+		{Name: "added", UID: "3"},
+	}
+
+	merged := MergeOwnerReferences(current, desired)
+
+	expected := []metav1.OwnerReference{
+		{Name: "kept", UID: "1"},
+		{Name: "replacing", UID: "2", Controller: ptr.To(true)},
+		{Name: "added", UID: "3"},
+	}
+	if !reflect.DeepEqual(merged, expected) {
+		t.Fatalf("merged == %#v, want %#v", merged, expected)
+	}
+	if current[1].Name != "replaced" {
+		t.Fatalf("current owner references must not be modified")
+	}
+}
+
+func Test_EqualOwnerReferenceApplyConfigurations(t *testing.T) {
+	testCases := []struct {
+		name     string
+		a        []metav1.OwnerReference
+		b        []metav1.OwnerReference
+		expected bool
+	}{
+		{
+			name:     "case 0: nil and empty owner references are equal",
+			a:        nil,
+			b:        []metav1.OwnerReference{},
+			expected: true,
+		},
+		{
+			name:     "case 1: same owner references are equal",
+			a:        []metav1.OwnerReference{{Name: "owner", UID: "1", Controller: ptr.To(true)}},
+			b:        []metav1.OwnerReference{{Name: "owner", UID: "1", Controller: ptr.To(true)}},
+			expected: true,
+		},
+		{
+			name:     "case 2: owner references with different fields are not equal",
+			a:        []metav1.OwnerReference{{Name: "owner", UID: "1", Controller: ptr.To(true)}},
+			b:        []metav1.OwnerReference{{Name: "owner", UID: "1", Controller: ptr.To(false)}},
+			expected: false,
+		},
+		{
+			name:     "case 3: owner references of different length are not equal",
+			a:        []metav1.OwnerReference{{Name: "owner", UID: "1"}},
+			b:        nil,
+			expected: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			var a, b []metav1apply.OwnerReferenceApplyConfiguration
+			for _, c := range NewOwnerReferenceApplyConfigurations(tc.a) {
+				a = append(a, *c)
+			}
+			for _, c := range NewOwnerReferenceApplyConfigurations(tc.b) {
+				b = append(b, *c)
+			}
+
+			equal := EqualOwnerReferenceApplyConfigurations(a, b)
+			if equal != tc.expected {
+				t.Fatalf("equal == %t, want %t", equal, tc.expected)
+			}
+		})
+	}
+}
+
+func newTestSecret(name string, controllerUID string) *corev1.Secret {
+	s := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+	}
+
+	if controllerUID != "" {
+		s.OwnerReferences = []metav1.OwnerReference{
+			{Kind: "Pod", Name: "owner", UID: types.UID(controllerUID), Controller: ptr.To(true)},
+		}
+	}
+
+	return s
+}
@@ -0,0 +1,11 @@
+// Package label defines the labels used by the k8s CRUD resources in order to
+// mark the objects they manage.
+package label
+
+const (
+	// ManagedBy is the label key identifying the operator managing an object.
+	ManagedBy = "app.kubernetes.io/managed-by"
+	// Resource is the label key identifying the operatorkit resource managing
+	// an object within the operator.
+	Resource = "operatorkit.giantswarm.io/resource"
+)
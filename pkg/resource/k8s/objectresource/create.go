@@ -0,0 +1,65 @@
+package objectresource
+
+import (
+	"context"
+
+	"github.com/giantswarm/microerror"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/operatorkit/v7/pkg/controller/context/dryruncontext"
+)
+
+// ApplyCreateChange ensures the objects are created in the k8s api.
+func (r *Resource[T]) ApplyCreateChange(ctx context.Context, obj, createChange interface{}) error {
+	objects, err := toObjects[T](createChange)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	var opts []client.CreateOption
+	if dryruncontext.IsDryRun(ctx) {
+		opts = append(opts, client.DryRunAll)
+	}
+
+	for _, object := range objects {
+		r.logger.Debugf(ctx, "creating %s %#q in namespace %#q", r.kind, object.GetName(), object.GetNamespace())
+
+		err = r.ctrlClient.Create(ctx, object, opts...)
+		if apierrors.IsAlreadyExists(err) {
+			r.logger.Debugf(ctx, "already created %s %#q in namespace %#q", r.kind, object.GetName(), object.GetNamespace())
+		} else if err != nil {
+			return microerror.Mask(err)
+		} else {
+			r.logger.Debugf(ctx, "created %s %#q in namespace %#q", r.kind, object.GetName(), object.GetNamespace())
+		}
+	}
+
+	return nil
+}
+
+func (r *Resource[T]) newCreateChange(ctx context.Context, obj, currentState, desiredState interface{}) (interface{}, error) {
+	currentObjects, err := toObjects[T](currentState)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+	desiredObjects, err := toObjects[T](desiredState)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	var objectsToCreate []T
+	{
+		r.logger.Debugf(ctx, "computing %s objects to create", r.kind)
+
+		for _, d := range desiredObjects {
+			if !containsObject(currentObjects, d) {
+				objectsToCreate = append(objectsToCreate, d)
+			}
+		}
+
+		r.logger.Debugf(ctx, "computed %d %s objects to create", len(objectsToCreate), r.kind)
+	}
+
+	return objectsToCreate, nil
+}
@@ -0,0 +1,16 @@
+package objectresource
+
+import (
+	"context"
+
+	"github.com/giantswarm/microerror"
+)
+
+func (r *Resource[T]) GetCurrentState(ctx context.Context, obj interface{}) (interface{}, error) {
+	state, err := r.stateGetter.GetCurrentState(ctx, obj)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return state, nil
+}
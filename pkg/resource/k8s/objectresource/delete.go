@@ -0,0 +1,73 @@
+package objectresource
+
+import (
+	"context"
+
+	"github.com/giantswarm/microerror"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/operatorkit/v7/pkg/controller/context/dryruncontext"
+)
+
+func (r *Resource[T]) ApplyDeleteChange(ctx context.Context, obj, deleteChange interface{}) error {
+	objectsToDelete, err := toObjects[T](deleteChange)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	var opts []client.DeleteOption
+	if dryruncontext.IsDryRun(ctx) {
+		opts = append(opts, client.DryRunAll)
+	}
+
+	for _, object := range objectsToDelete {
+		r.logger.Debugf(ctx, "deleting %s %#q in namespace %#q", r.kind, object.GetName(), object.GetNamespace())
+
+		err := r.ctrlClient.Delete(ctx, object, opts...)
+		if apierrors.IsNotFound(err) {
+			r.logger.Debugf(ctx, "already deleted %s %#q in namespace %#q", r.kind, object.GetName(), object.GetNamespace())
+		} else if err != nil {
+			return microerror.Mask(err)
+		} else {
+			r.logger.Debugf(ctx, "deleted %s %#q in namespace %#q", r.kind, object.GetName(), object.GetNamespace())
+		}
+	}
+
+	return nil
+}
+
+func (r *Resource[T]) newDeleteChangeForDeletePatch(ctx context.Context, obj, currentState, desiredState interface{}) ([]T, error) {
+	currentObjects, err := toObjects[T](currentState)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return currentObjects, nil
+}
+
+func (r *Resource[T]) newDeleteChangeForUpdatePatch(ctx context.Context, obj, currentState, desiredState interface{}) ([]T, error) {
+	currentObjects, err := toObjects[T](currentState)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+	desiredObjects, err := toObjects[T](desiredState)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	var objectsToDelete []T
+	{
+		r.logger.Debugf(ctx, "computing %s objects to delete", r.kind)
+
+		for _, c := range currentObjects {
+			if !containsObject(desiredObjects, c) {
+				objectsToDelete = append(objectsToDelete, c)
+			}
+		}
+
+		r.logger.Debugf(ctx, "computed %d %s objects to delete", len(objectsToDelete), r.kind)
+	}
+
+	return objectsToDelete, nil
+}
@@ -0,0 +1,16 @@
+package objectresource
+
+import (
+	"context"
+
+	"github.com/giantswarm/microerror"
+)
+
+func (r *Resource[T]) GetDesiredState(ctx context.Context, obj interface{}) (interface{}, error) {
+	state, err := r.stateGetter.GetDesiredState(ctx, obj)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return state, nil
+}
@@ -0,0 +1,119 @@
+package objectresource
+
+import (
+	"reflect"
+
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/operatorkit/v7/pkg/resource/crud"
+)
+
+type Config[T client.Object] struct {
+	CtrlClient  client.Client
+	Logger      micrologger.Logger
+	Merge       MergeFunc[T]
+	StateGetter StateGetter[T]
+
+	// Differ optionally renders the difference between the current and desired
+	// objects when they are updated, e.g. crud.ObjectDiffer. Objects holding
+	// sensitive data should have these fields redacted.
+	Differ crud.Differ
+	// Kind is the kind of the managed objects used in log messages. Defaults
+	// to the name of the type T points to.
+	Kind string
+	Name string
+	// ServerDryRun causes changes to be sent to the Kubernetes API server in
+	// dry-run mode when the controller runs in dry-run mode, so that they are
+	// validated, e.g. by admission webhooks, without being persisted. Without
+	// ServerDryRun no changes are sent at all in dry-run mode.
+	ServerDryRun bool
+}
+
+// Resource implements crud.Interface for any kind of Kubernetes object
+// managed through the controller-runtime client. T is the pointer type of the
+// managed object, e.g. *corev1.Service.
+type Resource[T client.Object] struct {
+	ctrlClient  client.Client
+	logger      micrologger.Logger
+	merge       MergeFunc[T]
+	stateGetter StateGetter[T]
+
+	differ       crud.Differ
+	kind         string
+	name         string
+	serverDryRun bool
+}
+
+func New[T client.Object](config Config[T]) (*Resource[T], error) {
+	if config.CtrlClient == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.CtrlClient must not be empty", config)
+	}
+	if config.Logger == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Logger must not be empty", config)
+	}
+	if config.Merge == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Merge must not be empty", config)
+	}
+	if config.StateGetter == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.StateGetter must not be empty", config)
+	}
+
+	if config.Name == "" {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Name must not be empty", config)
+	}
+
+	{
+		var zero T
+		t := reflect.TypeOf(zero)
+		if t == nil || t.Kind() != reflect.Ptr {
+			return nil, microerror.Maskf(invalidConfigError, "%T type parameter must be a pointer type", config)
+		}
+		if config.Kind == "" {
+			config.Kind = t.Elem().Name()
+		}
+	}
+
+	r := &Resource[T]{
+		ctrlClient:  config.CtrlClient,
+		logger:      config.Logger,
+		merge:       config.Merge,
+		stateGetter: config.StateGetter,
+
+		differ:       config.Differ,
+		kind:         config.Kind,
+		name:         config.Name,
+		serverDryRun: config.ServerDryRun,
+	}
+
+	return r, nil
+}
+
+// DryRun implements crud.DryRunner.
+func (r *Resource[T]) DryRun() bool {
+	return r.serverDryRun
+}
+
+func (r *Resource[T]) Name() string {
+	return r.name
+}
+
+func containsObject[T client.Object](objects []T, object T) bool {
+	for _, a := range objects {
+		if object.GetName() == a.GetName() && object.GetNamespace() == a.GetNamespace() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func toObjects[T client.Object](v interface{}) ([]T, error) {
+	x, ok := v.([]T)
+	if !ok {
+		return nil, microerror.Maskf(wrongTypeError, "expected '%T', got '%T'", x, v)
+	}
+
+	return x, nil
+}
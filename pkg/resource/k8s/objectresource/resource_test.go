@@ -0,0 +1,221 @@
+package objectresource
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/giantswarm/micrologger/microloggertest"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/giantswarm/operatorkit/v7/pkg/controller/context/dryruncontext"
+	"github.com/giantswarm/operatorkit/v7/pkg/resource/crud"
+)
+
+func Test_Resource_Interface(t *testing.T) {
+	var _ crud.Interface = &Resource[*corev1.ConfigMap]{}
+}
+
+type testStateGetter struct {
+	ctrlClient client.Client
+	desired    []*corev1.ConfigMap
+}
+
+func (g *testStateGetter) GetCurrentState(ctx context.Context, obj interface{}) ([]*corev1.ConfigMap, error) {
+	var current []*corev1.ConfigMap
+	for _, d := range g.desired {
+		c := &corev1.ConfigMap{}
+		err := g.ctrlClient.Get(ctx, client.ObjectKeyFromObject(d), c)
+		if apierrors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		current = append(current, c)
+	}
+
+	return current, nil
+}
+
+func (g *testStateGetter) GetDesiredState(ctx context.Context, obj interface{}) ([]*corev1.ConfigMap, error) {
+	return g.desired, nil
+}
+
+func mergeConfigMapData(current, desired *corev1.ConfigMap) *corev1.ConfigMap {
+	merged := current.DeepCopy()
+	merged.Data = desired.Data
+
+	return merged
+}
+
+func Test_Resource_EnsureCreated(t *testing.T) {
+	testCases := []struct {
+		name         string
+		current      []client.Object
+		desired      []*corev1.ConfigMap
+		expectedData map[string]string
+	}{
+		{
+			name: "case 0: object is created",
+			desired: []*corev1.ConfigMap{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+					Data:       map[string]string{"foo": "bar"},
+				},
+			},
+			expectedData: map[string]string{"foo": "bar"},
+		},
+		{
+			name: "case 1: fields of interest are updated",
+			current: []client.Object{
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default", Labels: map[string]string{"keep": "me"}},
+					Data:       map[string]string{"foo": "baz"},
+				},
+			},
+			desired: []*corev1.ConfigMap{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+					Data:       map[string]string{"foo": "bar"},
+				},
+			},
+			expectedData: map[string]string{"foo": "bar"},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			ctx := context.Background()
+			ctrlClient := fake.NewClientBuilder().WithObjects(tc.current...).Build()
+
+			var r *crud.Resource
+			{
+				c := Config[*corev1.ConfigMap]{
+					CtrlClient: ctrlClient,
+					Logger:     microloggertest.New(),
+					Merge:      mergeConfigMapData,
+					StateGetter: &testStateGetter{
+						ctrlClient: ctrlClient,
+						desired:    tc.desired,
+					},
+
+					Name: "test",
+				}
+
+				o, err := New(c)
+				if err != nil {
+					t.Fatalf("error == %#v, want nil", err)
+				}
+
+				r, err = crud.NewResource(crud.ResourceConfig{CRUD: o, Logger: microloggertest.New()})
+				if err != nil {
+					t.Fatalf("error == %#v, want nil", err)
+				}
+			}
+
+			err := r.EnsureCreated(ctx, nil)
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+
+			cm := &corev1.ConfigMap{}
+			err = ctrlClient.Get(ctx, client.ObjectKey{Name: "test", Namespace: "default"}, cm)
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+			if cm.Data["foo"] != tc.expectedData["foo"] {
+				t.Fatalf("data == %v, want %v", cm.Data, tc.expectedData)
+			}
+			for _, o := range tc.current {
+				for k, v := range o.GetLabels() {
+					if cm.Labels[k] != v {
+						t.Fatalf("label %#q == %#q, want %#q", k, cm.Labels[k], v)
+					}
+				}
+			}
+		})
+	}
+}
+
+func Test_Resource_EnsureDeleted(t *testing.T) {
+	ctx := context.Background()
+	desired := []*corev1.ConfigMap{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		},
+	}
+	ctrlClient := fake.NewClientBuilder().WithObjects(desired[0].DeepCopy()).Build()
+
+	o, err := New(Config[*corev1.ConfigMap]{
+		CtrlClient:  ctrlClient,
+		Logger:      microloggertest.New(),
+		Merge:       mergeConfigMapData,
+		StateGetter: &testStateGetter{ctrlClient: ctrlClient, desired: desired},
+
+		Name: "test",
+	})
+	if err != nil {
+		t.Fatalf("error == %#v, want nil", err)
+	}
+	r, err := crud.NewResource(crud.ResourceConfig{CRUD: o, Logger: microloggertest.New()})
+	if err != nil {
+		t.Fatalf("error == %#v, want nil", err)
+	}
+
+	err = r.EnsureDeleted(ctx, nil)
+	if err != nil {
+		t.Fatalf("error == %#v, want nil", err)
+	}
+
+	err = ctrlClient.Get(ctx, client.ObjectKeyFromObject(desired[0]), &corev1.ConfigMap{})
+	if !apierrors.IsNotFound(err) {
+		t.Fatalf("error == %#v, want not found", err)
+	}
+}
+
+func Test_Resource_ServerDryRun(t *testing.T) {
+	ctx := context.Background()
+	desired := []*corev1.ConfigMap{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		},
+	}
+	ctrlClient := fake.NewClientBuilder().Build()
+
+	o, err := New(Config[*corev1.ConfigMap]{
+		CtrlClient:  ctrlClient,
+		Logger:      microloggertest.New(),
+		Merge:       mergeConfigMapData,
+		StateGetter: &testStateGetter{ctrlClient: ctrlClient, desired: desired},
+
+		Name:         "test",
+		ServerDryRun: true,
+	})
+	if err != nil {
+		t.Fatalf("error == %#v, want nil", err)
+	}
+	r, err := crud.NewResource(crud.ResourceConfig{CRUD: o, Logger: microloggertest.New()})
+	if err != nil {
+		t.Fatalf("error == %#v, want nil", err)
+	}
+
+	plan := &dryruncontext.Plan{}
+	err = r.EnsureCreated(dryruncontext.NewContext(ctx, plan), nil)
+	if err != nil {
+		t.Fatalf("error == %#v, want nil", err)
+	}
+
+	if len(plan.Changes()) != 1 {
+		t.Fatalf("expected 1 planned change got %d", len(plan.Changes()))
+	}
+	err = ctrlClient.Get(ctx, client.ObjectKeyFromObject(desired[0]), &corev1.ConfigMap{})
+	if !apierrors.IsNotFound(err) {
+		t.Fatalf("error == %#v, want not found", err)
+	}
+}
@@ -0,0 +1,31 @@
+package objectresource
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// MergeFunc merges the fields of interest of the desired object into a copy of
+// the current object. The returned object is used as an argument to the Update
+// method of the controller-runtime client. An update is only issued if the
+// returned object differs from the current object. MergeFunc must not modify
+// any of its arguments.
+type MergeFunc[T client.Object] func(current, desired T) T
+
+type StateGetter[T client.Object] interface {
+	// GetCurrentState returns a current state of the system for the given
+	// incarnation of the observed Kubernetes object. The state consists of
+	// multiple objects but it is fine to return a slice of single object
+	// if only one object is managed by this resource.
+	GetCurrentState(ctx context.Context, obj interface{}) ([]T, error)
+	// GetDesiredState returns a desired state of the system for the given
+	// incarnation of the observed Kubernetes object. The state consists of
+	// multiple objects but it is fine to return a slice of single object
+	// if only one object is managed by this resource.
+	//
+	// NOTE: This state may be different if the observed object is
+	// created/updated or deleted. Deletion timestamp can be checked to
+	// figure if the object is scheduled for deletion.
+	GetDesiredState(ctx context.Context, obj interface{}) ([]T, error)
+}
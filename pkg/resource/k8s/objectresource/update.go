@@ -0,0 +1,88 @@
+package objectresource
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/giantswarm/microerror"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/operatorkit/v7/pkg/controller/context/dryruncontext"
+)
+
+func (r *Resource[T]) ApplyUpdateChange(ctx context.Context, obj, updateChange interface{}) error {
+	objects, err := toObjects[T](updateChange)
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	var opts []client.UpdateOption
+	if dryruncontext.IsDryRun(ctx) {
+		opts = append(opts, client.DryRunAll)
+	}
+
+	for _, object := range objects {
+		r.logger.Debugf(ctx, "updating %s %#q in namespace %#q", r.kind, object.GetName(), object.GetNamespace())
+
+		err = r.ctrlClient.Update(ctx, object, opts...)
+		if err != nil {
+			return microerror.Mask(err)
+		}
+
+		r.logger.Debugf(ctx, "updated %s %#q in namespace %#q", r.kind, object.GetName(), object.GetNamespace())
+	}
+
+	return nil
+}
+
+func (r *Resource[T]) newUpdateChange(ctx context.Context, obj, currentState, desiredState interface{}) (interface{}, error) {
+	currentObjects, err := toObjects[T](currentState)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+	desiredObjects, err := toObjects[T](desiredState)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	var objectsToUpdate []T
+	{
+		r.logger.Debugf(ctx, "computing %s objects to update", r.kind)
+
+		for _, c := range currentObjects {
+			for _, d := range desiredObjects {
+				m, ok := r.newObjectToUpdate(c, d)
+				if ok {
+					objectsToUpdate = append(objectsToUpdate, m)
+				}
+			}
+		}
+
+		r.logger.Debugf(ctx, "computed %d %s objects to update", len(objectsToUpdate), r.kind)
+	}
+
+	return objectsToUpdate, nil
+}
+
+// newObjectToUpdate returns the object to be used as an argument to the Update
+// method of the controller-runtime client. It returns false if the name or
+// namespace doesn't match or if the objects don't have differences in the
+// scope of interest defined by the configured MergeFunc.
+func (r *Resource[T]) newObjectToUpdate(current, desired T) (T, bool) {
+	var zero T
+
+	if current.GetNamespace() != desired.GetNamespace() {
+		return zero, false
+	}
+	if current.GetName() != desired.GetName() {
+		return zero, false
+	}
+
+	merged := r.merge(current, desired)
+
+	if reflect.DeepEqual(current, merged) {
+		return zero, false
+	}
+
+	return merged, true
+}
@@ -0,0 +1,77 @@
+package secretresource
+
+import (
+	"bytes"
+	"maps"
+	"reflect"
+
+	"github.com/giantswarm/microerror"
+	corev1 "k8s.io/api/core/v1"
+	corev1apply "k8s.io/client-go/applyconfigurations/core/v1"
+
+	"github.com/giantswarm/operatorkit/v7/pkg/resource/k8s/internal/ownership"
+)
+
+// newSecretApplyConfiguration creates the apply configuration of the given
+// desired Secret used with server-side apply. It only contains the fields
+// managed by this resource. StringData is merged into Data since StringData is
+// write-only and would otherwise never match the current state.
+func newSecretApplyConfiguration(desired *corev1.Secret) *corev1apply.SecretApplyConfiguration {
+	var data map[string][]byte
+	{
+		if len(desired.Data) != 0 || len(desired.StringData) != 0 {
+			data = map[string][]byte{}
+		}
+		for k, v := range desired.Data {
+			data[k] = v
+		}
+		for k, v := range desired.StringData {
+			data[k] = []byte(v)
+		}
+	}
+
+	a := corev1apply.Secret(desired.Name, desired.Namespace).
+		WithAnnotations(desired.Annotations).
+		WithLabels(desired.Labels).
+		WithData(data)
+
+	if desired.Type != "" {
+		a = a.WithType(desired.Type)
+	}
+
+	if len(desired.OwnerReferences) != 0 {
+		a = a.WithOwnerReferences(ownership.NewOwnerReferenceApplyConfigurations(desired.OwnerReferences)...)
+	}
+
+	return a
+}
+
+// newSecretToApply returns the desired Secret in case it has to be applied
+// using server-side apply. It returns nil if the name or namespace doesn't
+// match or if the fields owned by the given field manager in the current Secret
+// don't differ from the desired Secret. Fields owned by other field managers
+// are not taken into account.
+func newSecretToApply(current, desired *corev1.Secret, fieldManager string) (*corev1.Secret, error) {
+	if current.Namespace != desired.Namespace {
+		return nil, nil
+	}
+	if current.Name != desired.Name {
+		return nil, nil
+	}
+
+	owned, err := corev1apply.ExtractSecret(current, fieldManager)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+	applied := newSecretApplyConfiguration(desired)
+
+	if maps.Equal(owned.Annotations, applied.Annotations) &&
+		maps.Equal(owned.Labels, applied.Labels) &&
+		ownership.EqualOwnerReferenceApplyConfigurations(owned.OwnerReferences, applied.OwnerReferences) &&
+		maps.EqualFunc(owned.Data, applied.Data, bytes.Equal) &&
+		(applied.Type == nil || reflect.DeepEqual(owned.Type, applied.Type)) {
+		return nil, nil
+	}
+
+	return desired, nil
+}
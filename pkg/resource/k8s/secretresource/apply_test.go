@@ -0,0 +1,190 @@
+package secretresource
+
+import (
+	"strconv"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_newSecretToApply(t *testing.T) {
+	current := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+			Labels: map[string]string{
+				"owned-by-someone-else": "true",
+			},
+			ManagedFields: []metav1.ManagedFieldsEntry{
+				{
+					Manager:    "test-operator",
+					Operation:  metav1.ManagedFieldsOperationApply,
+					APIVersion: "v1",
+					FieldsType: "FieldsV1",
+					FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:data":{"f:foo":{}},"f:type":{}}`)},
+				},
+				{
+					Manager:    "someone-else",
+					Operation:  metav1.ManagedFieldsOperationApply,
+					APIVersion: "v1",
+					FieldsType: "FieldsV1",
+					FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:data":{"f:bar":{}},"f:metadata":{"f:labels":{"f:owned-by-someone-else":{}}}}`)},
+				},
+			},
+		},
+		Data: map[string][]byte{
+			"foo": []byte("1"),
+			"bar": []byte("2"),
+		},
+		Type: corev1.SecretTypeOpaque,
+	}
+
+	testCases := []struct {
+		name            string
+		desired         *corev1.Secret
+		expectedToApply bool
+	}{
+		{
+			name: "case 0: owned fields match, foreign fields are ignored",
+			desired: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Data: map[string][]byte{
+					"foo": []byte("1"),
+				},
+				Type: corev1.SecretTypeOpaque,
+			},
+			expectedToApply: false,
+		},
+		{
+			name: "case 1: owned field differs",
+			desired: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Data: map[string][]byte{
+					"foo": []byte("2"),
+				},
+				Type: corev1.SecretTypeOpaque,
+			},
+			expectedToApply: true,
+		},
+		{
+			name: "case 2: string data matching owned data",
+			desired: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				StringData: map[string]string{
+					"foo": "1",
+				},
+				Type: corev1.SecretTypeOpaque,
+			},
+			expectedToApply: false,
+		},
+		{
+			name: "case 3: string data differing from owned data",
+			desired: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				StringData: map[string]string{
+					"foo": "2",
+				},
+				Type: corev1.SecretTypeOpaque,
+			},
+			expectedToApply: true,
+		},
+		{
+			name: "case 4: string data takes precedence over data",
+			desired: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Data: map[string][]byte{
+					"foo": []byte("2"),
+				},
+				StringData: map[string]string{
+					"foo": "1",
+				},
+				Type: corev1.SecretTypeOpaque,
+			},
+			expectedToApply: false,
+		},
+		{
+			name: "case 5: type differs",
+			desired: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Data: map[string][]byte{
+					"foo": []byte("1"),
+				},
+				Type: corev1.SecretTypeBasicAuth,
+			},
+			expectedToApply: true,
+		},
+		{
+			name: "case 6: empty type is not compared",
+			desired: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Data: map[string][]byte{
+					"foo": []byte("1"),
+				},
+			},
+			expectedToApply: false,
+		},
+		{
+			name: "case 7: new field desired",
+			desired: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+					Labels: map[string]string{
+						"app": "test",
+					},
+				},
+				Data: map[string][]byte{
+					"foo": []byte("1"),
+				},
+			},
+			expectedToApply: true,
+		},
+		{
+			name: "case 8: different name",
+			desired: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "other",
+					Namespace: "default",
+				},
+			},
+			expectedToApply: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			s, err := newSecretToApply(current, tc.desired, "test-operator")
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+
+			if (s != nil) != tc.expectedToApply {
+				t.Fatalf("expected to apply %t got %t", tc.expectedToApply, s != nil)
+			}
+		})
+	}
+}
@@ -17,9 +17,22 @@ func (r *Resource) ApplyCreateChange(ctx context.Context, obj, createChange inte
 	}
 
 	for _, secret := range secrets {
+		if r.serverSideApply {
+			r.logger.Debugf(ctx, "applying Secret %#q in namespace %#q", secret.Name, secret.Namespace)
+
+			_, err = r.k8sClient.CoreV1().Secrets(secret.Namespace).Apply(ctx, newSecretApplyConfiguration(secret), r.applyOptions(ctx))
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			r.logger.Debugf(ctx, "applied Secret %#q in namespace %#q", secret.Name, secret.Namespace)
+
+			continue
+		}
+
 		r.logger.Debugf(ctx, "creating Secret %#q in namespace %#q", secret.Name, secret.Namespace)
 
-		_, err = r.k8sClient.CoreV1().Secrets(secret.Namespace).Create(ctx, secret, metav1.CreateOptions{})
+		_, err = r.k8sClient.CoreV1().Secrets(secret.Namespace).Create(ctx, secret, metav1.CreateOptions{DryRun: dryRun(ctx)})
 		if apierrors.IsAlreadyExists(err) {
 			r.logger.Debugf(ctx, "already created Secret %#q in namespace %#q", secret.Name, secret.Namespace)
 		} else if err != nil {
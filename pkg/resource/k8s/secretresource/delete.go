@@ -7,6 +7,8 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/operatorkit/v7/pkg/resource/k8s/internal/ownership"
 )
 
 func (r *Resource) ApplyDeleteChange(ctx context.Context, obj, deleteChange interface{}) error {
@@ -18,7 +20,7 @@ func (r *Resource) ApplyDeleteChange(ctx context.Context, obj, deleteChange inte
 	for _, secret := range secretsToDelete {
 		r.logger.Debugf(ctx, "deleting Secret %#q in namespace %#q", secret.Name, secret.Namespace)
 
-		err := r.k8sClient.CoreV1().Secrets(secret.Namespace).Delete(ctx, secret.Name, metav1.DeleteOptions{})
+		err := r.k8sClient.CoreV1().Secrets(secret.Namespace).Delete(ctx, secret.Name, metav1.DeleteOptions{DryRun: dryRun(ctx)})
 		if apierrors.IsNotFound(err) {
 			r.logger.Debugf(ctx, "already deleted Secret %#q in namespace %#q", secret.Name, secret.Namespace)
 		} else if err != nil {
@@ -37,6 +39,22 @@ func (r *Resource) newDeleteChangeForDeletePatch(ctx context.Context, obj, curre
 		return nil, microerror.Mask(err)
 	}
 
+	if r.ownerReferences {
+		currentSecrets, err = ownership.WithoutGarbageCollected(ctx, r.logger, obj, currentSecrets)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	if r.managedBy != "" {
+		for _, c := range currentSecrets {
+			err = ownership.ValidateManaged(c, r.managedBy, r.name)
+			if err != nil {
+				return nil, microerror.Mask(err)
+			}
+		}
+	}
+
 	return currentSecrets, nil
 }
 
@@ -56,6 +74,13 @@ func (r *Resource) newDeleteChangeForUpdatePatch(ctx context.Context, obj, curre
 
 		for _, c := range currentSecrets {
 			if !containsSecret(c, desiredSecrets) {
+				if r.managedBy != "" {
+					err = ownership.ValidateManaged(c, r.managedBy, r.name)
+					if err != nil {
+						return nil, microerror.Mask(err)
+					}
+				}
+
 				secretsToDelete = append(secretsToDelete, c)
 			}
 		}
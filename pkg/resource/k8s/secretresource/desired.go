@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/giantswarm/microerror"
+
+	"github.com/giantswarm/operatorkit/v7/pkg/resource/k8s/internal/ownership"
 )
 
 func (r *Resource) GetDesiredState(ctx context.Context, obj interface{}) (interface{}, error) {
@@ -12,5 +14,16 @@ func (r *Resource) GetDesiredState(ctx context.Context, obj interface{}) (interf
 		return nil, microerror.Mask(err)
 	}
 
+	if r.ownerReferences {
+		err = ownership.SetOwnerReferences(obj, state, r.scheme)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	if r.managedBy != "" {
+		ownership.SetManagedLabels(state, r.managedBy, r.name)
+	}
+
 	return state, nil
 }
@@ -1,6 +1,10 @@
 package secretresource
 
-import "github.com/giantswarm/microerror"
+import (
+	"github.com/giantswarm/microerror"
+
+	"github.com/giantswarm/operatorkit/v7/pkg/resource/k8s/internal/ownership"
+)
 
 var invalidConfigError = &microerror.Error{
 	Kind: "invalidConfigError",
@@ -17,5 +21,15 @@ var wrongTypeError = &microerror.Error{
 
 // IsWrongTypeError asserts wrongTypeError.
 func IsWrongTypeError(err error) bool {
-	return microerror.Cause(err) == wrongTypeError
+	return microerror.Cause(err) == wrongTypeError || ownership.IsWrongTypeError(err)
+}
+
+// IsInvalidOwner asserts invalidOwnerError.
+func IsInvalidOwner(err error) bool {
+	return ownership.IsInvalidOwner(err)
+}
+
+// IsUnmanagedObject asserts unmanagedObjectError.
+func IsUnmanagedObject(err error) bool {
+	return ownership.IsUnmanagedObject(err)
 }
@@ -0,0 +1,153 @@
+package secretresource
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/giantswarm/micrologger/microloggertest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/giantswarm/operatorkit/v7/pkg/resource/k8s/internal/ownership"
+	"github.com/giantswarm/operatorkit/v7/pkg/resource/k8s/label"
+)
+
+func Test_Resource_ManagedBy(t *testing.T) {
+	managed := map[string]string{
+		label.ManagedBy: "test-operator",
+		label.Resource:  "test",
+	}
+
+	testCases := []struct {
+		name         string
+		current      []*corev1.Secret
+		desired      []*corev1.Secret
+		errorMatcher func(error) bool
+	}{
+		{
+			name: "case 0: managed Secret is updated",
+			current: []*corev1.Secret{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default", Labels: managed},
+					Data:       map[string][]byte{"foo": []byte("bar")},
+				},
+			},
+		},
+		{
+			name: "case 1: unmanaged Secret is not updated",
+			current: []*corev1.Secret{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+					Data:       map[string][]byte{"foo": []byte("bar")},
+				},
+			},
+			errorMatcher: IsUnmanagedObject,
+		},
+		{
+			name: "case 2: managed Secret is deleted",
+			current: []*corev1.Secret{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default", Labels: managed},
+				},
+			},
+		},
+		{
+			name: "case 3: unmanaged Secret is not deleted",
+			current: []*corev1.Secret{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"},
+				},
+			},
+			errorMatcher: IsUnmanagedObject,
+		},
+		{
+			name: "case 4: Secret managed by another resource is not deleted",
+			current: []*corev1.Secret{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "other",
+						Namespace: "default",
+						Labels: map[string]string{
+							label.ManagedBy: "test-operator",
+							label.Resource:  "another",
+						},
+					},
+				},
+			},
+			errorMatcher: IsUnmanagedObject,
+		},
+		{
+			name: "case 5: unmanaged Secret not requiring any update is ignored",
+			current: []*corev1.Secret{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+					Data:       map[string][]byte{"foo": []byte("bar")},
+				},
+			},
+			desired: []*corev1.Secret{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+					Data:       map[string][]byte{"foo": []byte("bar")},
+				},
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			ctx := context.Background()
+
+			var r *Resource
+			{
+				c := Config{
+					K8sClient:   fake.NewClientset(),
+					Logger:      microloggertest.New(),
+					StateGetter: &testStateGetter{},
+
+					ManagedBy: "test-operator",
+					Name:      "test",
+				}
+
+				var err error
+				r, err = New(c)
+				if err != nil {
+					t.Fatalf("error == %#v, want nil", err)
+				}
+			}
+
+			desired, err := r.GetDesiredState(ctx, nil)
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+
+			secrets, err := toSecrets(desired)
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+			if ownership.ValidateManaged(secrets[0], r.managedBy, r.name) != nil {
+				t.Fatalf("expected desired Secret to be labelled got %v", secrets[0].Labels)
+			}
+
+			if tc.desired != nil {
+				desired = tc.desired
+			}
+
+			_, err = r.NewUpdatePatch(ctx, nil, tc.current, desired)
+
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				// correct; carry on
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+		})
+	}
+}
@@ -38,6 +38,7 @@ func (r *Resource) NewUpdatePatch(ctx context.Context, obj, currentState, desire
 	patch.SetCreateChange(create)
 	patch.SetDeleteChange(delete)
 	patch.SetUpdateChange(update)
+	patch.SetDiffer(r.differ)
 
 	return patch, nil
 }
@@ -1,10 +1,18 @@
 package secretresource
 
 import (
+	"context"
+
 	"github.com/giantswarm/microerror"
 	"github.com/giantswarm/micrologger"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"github.com/giantswarm/operatorkit/v7/pkg/controller/context/dryruncontext"
+	"github.com/giantswarm/operatorkit/v7/pkg/resource/crud"
 )
 
 type Config struct {
@@ -13,7 +21,47 @@ type Config struct {
 	StateGetter StateGetter
 
 	AllowedLabels []string
-	Name          string
+	// Differ renders the difference between the current and desired Secrets
+	// when they are updated. Defaults to a crud.ObjectDiffer rendering unified
+	// diffs with the values of data, stringData and the last applied
+	// configuration annotation redacted. Custom differs must redact these
+	// fields themselves.
+	Differ crud.Differ
+	// FieldManager is the field manager used with server-side apply. Defaults
+	// to Name.
+	FieldManager string
+	// ForceConflicts causes server-side apply to take ownership of fields
+	// owned by other field managers instead of failing with a conflict.
+	ForceConflicts bool
+	// ManagedBy enables ownership labels. Secrets created by this resource are
+	// labelled with ManagedBy and Name. Secrets lacking these labels are never
+	// updated or deleted. Instead reconciliation fails with an error which is
+	// also emitted as Kubernetes event. Secrets created before ManagedBy was
+	// configured have to be labelled manually in order to be adopted.
+	ManagedBy string
+	Name      string
+	// OwnerReferences enables setting a controller owner reference pointing to
+	// the reconciled object on all desired Secrets. Secrets owned this way are
+	// deleted by the Kubernetes garbage collector once the reconciled object is
+	// deleted, instead of being deleted by this resource. The reconciled object
+	// must either be cluster scoped or live in the same namespace as the
+	// Secrets.
+	OwnerReferences bool
+	// Scheme is used to look up the kind of the reconciled object when
+	// setting owner references. Defaults to the client-go scheme, which
+	// requires custom resources to be registered with it.
+	Scheme *runtime.Scheme
+	// ServerDryRun causes changes to be sent to the Kubernetes API server in
+	// dry-run mode when the controller runs in dry-run mode, so that they are
+	// validated, e.g. by admission webhooks, without being persisted. Without
+	// ServerDryRun no changes are sent at all in dry-run mode.
+	ServerDryRun bool
+	// ServerSideApply enables server-side apply for creating and updating
+	// Secrets. Only the fields owned by FieldManager are then compared in order
+	// to detect drift, so that Secrets can be co-owned with users and other
+	// controllers. AllowedLabels has no effect in this mode since labels owned
+	// by other field managers are retained anyway.
+	ServerSideApply bool
 }
 
 type Resource struct {
@@ -21,11 +69,21 @@ type Resource struct {
 	logger      micrologger.Logger
 	stateGetter StateGetter
 
-	allowedLabels map[string]bool
-	name          string
+	allowedLabels   map[string]bool
+	differ          crud.Differ
+	fieldManager    string
+	forceConflicts  bool
+	managedBy       string
+	name            string
+	ownerReferences bool
+	scheme          *runtime.Scheme
+	serverDryRun    bool
+	serverSideApply bool
 }
 
 func New(config Config) (*Resource, error) {
+	var err error
+
 	if config.K8sClient == nil {
 		return nil, microerror.Maskf(invalidConfigError, "%T.K8sClient must not be empty", config)
 	}
@@ -39,13 +97,41 @@ func New(config Config) (*Resource, error) {
 	if config.Name == "" {
 		return nil, microerror.Maskf(invalidConfigError, "%T.Name must not be empty", config)
 	}
+	if config.Differ == nil {
+		c := crud.ObjectDifferConfig{
+			RedactedFields: []string{
+				crud.LastAppliedConfigurationField,
+				"data",
+				"stringData",
+			},
+		}
+
+		config.Differ, err = crud.NewObjectDiffer(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+	if config.FieldManager == "" {
+		config.FieldManager = config.Name
+	}
+	if config.Scheme == nil {
+		config.Scheme = scheme.Scheme
+	}
 
 	r := &Resource{
 		k8sClient:   config.K8sClient,
 		logger:      config.Logger,
 		stateGetter: config.StateGetter,
 
-		name: config.Name,
+		differ:          config.Differ,
+		fieldManager:    config.FieldManager,
+		forceConflicts:  config.ForceConflicts,
+		managedBy:       config.ManagedBy,
+		name:            config.Name,
+		ownerReferences: config.OwnerReferences,
+		scheme:          config.Scheme,
+		serverDryRun:    config.ServerDryRun,
+		serverSideApply: config.ServerSideApply,
 	}
 
 	if config.AllowedLabels != nil {
@@ -62,6 +148,11 @@ func New(config Config) (*Resource, error) {
 	return r, nil
 }
 
+// DryRun implements crud.DryRunner.
+func (r *Resource) DryRun() bool {
+	return r.serverDryRun
+}
+
 func (r *Resource) Name() string {
 	return r.name
 }
@@ -76,6 +167,24 @@ func containsSecret(cr *corev1.Secret, crs []*corev1.Secret) bool {
 	return false
 }
 
+func (r *Resource) applyOptions(ctx context.Context) metav1.ApplyOptions {
+	return metav1.ApplyOptions{
+		DryRun:       dryRun(ctx),
+		FieldManager: r.fieldManager,
+		Force:        r.forceConflicts,
+	}
+}
+
+// dryRun returns the dry-run option of requests sent to the Kubernetes API
+// server, which is only set when the controller runs in dry-run mode.
+func dryRun(ctx context.Context) []string {
+	if dryruncontext.IsDryRun(ctx) {
+		return []string{metav1.DryRunAll}
+	}
+
+	return nil
+}
+
 func toSecrets(v interface{}) ([]*corev1.Secret, error) {
 	x, ok := v.([]*corev1.Secret)
 	if !ok {
@@ -0,0 +1,154 @@
+package secretresource
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/giantswarm/micrologger/microloggertest"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func Test_Resource_Differ(t *testing.T) {
+	testCases := []struct {
+		name          string
+		currentState  []*corev1.Secret
+		desiredState  []*corev1.Secret
+		secrets       []string
+		expectChanged bool
+	}{
+		{
+			name: "case 0: changed data is not rendered",
+			currentState: []*corev1.Secret{
+				newTestSecret(map[string][]byte{"key": []byte("old-secret")}, nil, nil),
+			},
+			desiredState: []*corev1.Secret{
+				newTestSecret(map[string][]byte{"key": []byte("new-secret")}, nil, nil),
+			},
+			secrets:       []string{"old-secret", "new-secret"},
+			expectChanged: true,
+		},
+		{
+			name: "case 1: added string data is not rendered",
+			currentState: []*corev1.Secret{
+				newTestSecret(nil, nil, nil),
+			},
+			desiredState: []*corev1.Secret{
+				newTestSecret(nil, map[string]string{"key": "new-secret"}, nil),
+			},
+			secrets:       []string{"new-secret"},
+			expectChanged: true,
+		},
+		{
+			name: "case 2: removed data is not rendered",
+			currentState: []*corev1.Secret{
+				newTestSecret(map[string][]byte{"key": []byte("old-secret")}, nil, nil),
+			},
+			desiredState: []*corev1.Secret{
+				newTestSecret(nil, nil, nil),
+			},
+			secrets:       []string{"old-secret"},
+			expectChanged: true,
+		},
+		{
+			name: "case 3: the last applied configuration is not rendered",
+			currentState: []*corev1.Secret{
+				newTestSecret(
+					map[string][]byte{"key": []byte("old-secret")},
+					nil,
+					map[string]string{"kubectl.kubernetes.io/last-applied-configuration": `{"stringData":{"key":"old-secret"}}`},
+				),
+			},
+			desiredState: []*corev1.Secret{
+				newTestSecret(map[string][]byte{"key": []byte("new-secret")}, nil, map[string]string{"other": "annotation"}),
+			},
+			secrets:       []string{"old-secret", "new-secret"},
+			expectChanged: true,
+		},
+		{
+			name: "case 4: unchanged data is not rendered as difference",
+			currentState: []*corev1.Secret{
+				newTestSecret(map[string][]byte{"key": []byte("secret")}, nil, nil),
+			},
+			desiredState: []*corev1.Secret{
+				newTestSecret(map[string][]byte{"key": []byte("secret")}, nil, nil),
+			},
+			secrets:       []string{"secret"},
+			expectChanged: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			var r *Resource
+			{
+				c := Config{
+					K8sClient:   fake.NewClientset(),
+					Logger:      microloggertest.New(),
+					StateGetter: &testStateGetter{},
+
+					Name: "test",
+				}
+
+				var err error
+				r, err = New(c)
+				if err != nil {
+					t.Fatalf("error == %#v, want nil", err)
+				}
+			}
+
+			d, err := r.differ.Diff(tc.currentState, tc.desiredState)
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+
+			if tc.expectChanged && d == "" {
+				t.Fatalf("diff == %q, want non-empty", d)
+			}
+			if !tc.expectChanged && d != "" {
+				t.Fatalf("diff == %q, want empty", d)
+			}
+			for _, s := range tc.secrets {
+				for _, v := range []string{s, base64.StdEncoding.EncodeToString([]byte(s))} {
+					if strings.Contains(d, v) {
+						t.Fatalf("diff == %q, want it not to contain %q", d, v)
+					}
+				}
+			}
+		})
+	}
+}
+
+func newTestSecret(data map[string][]byte, stringData map[string]string, annotations map[string]string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test",
+			Namespace:   "default",
+			Annotations: annotations,
+		},
+		Data:       data,
+		StringData: stringData,
+	}
+}
+
+type testStateGetter struct{}
+
+func (g *testStateGetter) GetCurrentState(ctx context.Context, obj interface{}) ([]*corev1.Secret, error) {
+	return nil, nil
+}
+
+func (g *testStateGetter) GetDesiredState(ctx context.Context, obj interface{}) ([]*corev1.Secret, error) {
+	secrets := []*corev1.Secret{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		},
+	}
+
+	return secrets, nil
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/giantswarm/microerror"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/giantswarm/operatorkit/v7/pkg/resource/k8s/internal/ownership"
 )
 
 func (r *Resource) ApplyUpdateChange(ctx context.Context, obj, updateChange interface{}) error {
@@ -15,9 +17,22 @@ func (r *Resource) ApplyUpdateChange(ctx context.Context, obj, updateChange inte
 	}
 
 	for _, secret := range secrets {
+		if r.serverSideApply {
+			r.logger.Debugf(ctx, "applying Secret %#q in namespace %#q", secret.Name, secret.Namespace)
+
+			_, err = r.k8sClient.CoreV1().Secrets(secret.Namespace).Apply(ctx, newSecretApplyConfiguration(secret), r.applyOptions(ctx))
+			if err != nil {
+				return microerror.Mask(err)
+			}
+
+			r.logger.Debugf(ctx, "applied Secret %#q in namespace %#q", secret.Name, secret.Namespace)
+
+			continue
+		}
+
 		r.logger.Debugf(ctx, "updating Secret %#q in namespace %#q", secret.Name, secret.Namespace)
 
-		_, err = r.k8sClient.CoreV1().Secrets(secret.Namespace).Update(ctx, secret, metav1.UpdateOptions{})
+		_, err = r.k8sClient.CoreV1().Secrets(secret.Namespace).Update(ctx, secret, metav1.UpdateOptions{DryRun: dryRun(ctx)})
 		if err != nil {
 			return microerror.Mask(err)
 		}
@@ -44,8 +59,33 @@ func (r *Resource) newUpdateChange(ctx context.Context, obj, currentState, desir
 
 		for _, c := range currentSecrets {
 			for _, d := range desiredSecrets {
-				m := newSecretToUpdate(c, d, r.allowedLabels)
+				if r.ownerReferences {
+					err = ownership.ValidateOwner(c, d)
+					if err != nil {
+						return nil, microerror.Mask(err)
+					}
+				}
+
+				var m *corev1.Secret
+				if r.serverSideApply {
+					m, err = newSecretToApply(c, d, r.fieldManager)
+					if err != nil {
+						return nil, microerror.Mask(err)
+					}
+				} else {
+					m = newSecretToUpdate(c, d, r.allowedLabels)
+				}
+
 				if m != nil {
+					// Objects are only required to be managed by this
+					// resource in case they are actually updated.
+					if r.managedBy != "" {
+						err = ownership.ValidateManaged(c, r.managedBy, r.name)
+						if err != nil {
+							return nil, microerror.Mask(err)
+						}
+					}
+
 					secretsToUpdate = append(secretsToUpdate, m)
 				}
 			}
@@ -0,0 +1,47 @@
+package unstructuredresource
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// newMergeFunc returns a merge function copying the given field paths from
+// the desired object into a copy of the current object. Fields missing in the
+// desired object are removed from the copy.
+func newMergeFunc(fields [][]string) func(current, desired *unstructured.Unstructured) *unstructured.Unstructured {
+	return func(current, desired *unstructured.Unstructured) *unstructured.Unstructured {
+		merged := current.DeepCopy()
+
+		for _, f := range fields {
+			v, ok, err := unstructured.NestedFieldCopy(desired.Object, f...)
+			if err != nil || !ok {
+				unstructured.RemoveNestedField(merged.Object, f...)
+				continue
+			}
+
+			removeNonMapParent(merged.Object, f)
+			_ = unstructured.SetNestedField(merged.Object, v, f...)
+		}
+
+		return merged
+	}
+}
+
+// removeNonMapParent removes the first value on the given field path which is
+// not a map, so that a value can be set on the full field path afterwards.
+func removeNonMapParent(obj map[string]interface{}, fields []string) {
+	m := obj
+	for i, f := range fields[:len(fields)-1] {
+		v, ok := m[f]
+		if !ok {
+			return
+		}
+
+		n, ok := v.(map[string]interface{})
+		if !ok {
+			unstructured.RemoveNestedField(obj, fields[:i+1]...)
+			return
+		}
+
+		m = n
+	}
+}
@@ -0,0 +1,109 @@
+package unstructuredresource
+
+import (
+	"strings"
+
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/giantswarm/operatorkit/v7/pkg/resource/crud"
+	"github.com/giantswarm/operatorkit/v7/pkg/resource/k8s/objectresource"
+)
+
+// DefaultFields is the list of field paths compared and updated when
+// Config.Fields is empty.
+var DefaultFields = []string{
+	"metadata.annotations",
+	"metadata.labels",
+	"spec",
+}
+
+type Config struct {
+	CtrlClient  client.Client
+	Logger      micrologger.Logger
+	StateGetter objectresource.StateGetter[*unstructured.Unstructured]
+
+	// Differ optionally renders the difference between the current and desired
+	// objects when they are updated, e.g. crud.ObjectDiffer. Objects holding
+	// sensitive data should have these fields redacted.
+	Differ crud.Differ
+	// Fields is the allow list of dot separated field paths, e.g.
+	// "spec.replicas", compared between the current and desired objects. Only
+	// these fields are copied from the desired object when updating the
+	// current object, all other fields are retained. A field missing in the
+	// desired object is removed from the current object. Map entries whose keys
+	// contain dots, like most labels, can only be addressed by their parent
+	// field, e.g. "metadata.labels". Defaults to DefaultFields.
+	Fields []string
+	// GroupVersionKind is the kind of the managed objects. It is set on
+	// objects returned by StateGetter not specifying any kind and objects of
+	// any other kind are rejected.
+	GroupVersionKind schema.GroupVersionKind
+	Name             string
+	// ServerDryRun causes changes to be sent to the Kubernetes API server in
+	// dry-run mode when the controller runs in dry-run mode, so that they are
+	// validated, e.g. by admission webhooks, without being persisted. Without
+	// ServerDryRun no changes are sent at all in dry-run mode.
+	ServerDryRun bool
+}
+
+// New creates a CRUD resource managing objects of an arbitrary kind as
+// unstructured.Unstructured, so that the kind does not have to be registered
+// in any scheme at compile time.
+func New(config Config) (*objectresource.Resource[*unstructured.Unstructured], error) {
+	if config.StateGetter == nil {
+		return nil, microerror.Maskf(invalidConfigError, "%T.StateGetter must not be empty", config)
+	}
+
+	if config.Fields == nil {
+		config.Fields = DefaultFields
+	}
+	if config.GroupVersionKind.Kind == "" {
+		return nil, microerror.Maskf(invalidConfigError, "%T.GroupVersionKind.Kind must not be empty", config)
+	}
+	if config.GroupVersionKind.Version == "" {
+		return nil, microerror.Maskf(invalidConfigError, "%T.GroupVersionKind.Version must not be empty", config)
+	}
+
+	var fields [][]string
+	for _, f := range config.Fields {
+		if f == "" {
+			return nil, microerror.Maskf(invalidConfigError, "%T.Fields must not contain empty field paths", config)
+		}
+		fields = append(fields, strings.Split(f, "."))
+	}
+
+	var stateGetter objectresource.StateGetter[*unstructured.Unstructured]
+	{
+		stateGetter = &kindStateGetter{
+			gvk:        config.GroupVersionKind,
+			underlying: config.StateGetter,
+		}
+	}
+
+	var err error
+	var r *objectresource.Resource[*unstructured.Unstructured]
+	{
+		c := objectresource.Config[*unstructured.Unstructured]{
+			CtrlClient:  config.CtrlClient,
+			Logger:      config.Logger,
+			Merge:       newMergeFunc(fields),
+			StateGetter: stateGetter,
+
+			Differ:       config.Differ,
+			Kind:         config.GroupVersionKind.Kind,
+			Name:         config.Name,
+			ServerDryRun: config.ServerDryRun,
+		}
+
+		r, err = objectresource.New(c)
+		if err != nil {
+			return nil, microerror.Mask(err)
+		}
+	}
+
+	return r, nil
+}
@@ -0,0 +1,242 @@
+package unstructuredresource
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/giantswarm/micrologger/microloggertest"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/giantswarm/operatorkit/v7/pkg/resource/crud"
+)
+
+var testGVK = schema.GroupVersionKind{
+	Group:   "example.giantswarm.io",
+	Version: "v1alpha1",
+	Kind:    "Example",
+}
+
+type testStateGetter struct {
+	ctrlClient client.Client
+	desired    []*unstructured.Unstructured
+}
+
+func (g *testStateGetter) GetCurrentState(ctx context.Context, obj interface{}) ([]*unstructured.Unstructured, error) {
+	var current []*unstructured.Unstructured
+	for _, d := range g.desired {
+		c := &unstructured.Unstructured{}
+		c.SetGroupVersionKind(testGVK)
+		err := g.ctrlClient.Get(ctx, client.ObjectKeyFromObject(d), c)
+		if apierrors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		current = append(current, c)
+	}
+
+	return current, nil
+}
+
+func (g *testStateGetter) GetDesiredState(ctx context.Context, obj interface{}) ([]*unstructured.Unstructured, error) {
+	return g.desired, nil
+}
+
+func newTestObject(gvk schema.GroupVersionKind, spec map[string]interface{}) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"name":      "test",
+				"namespace": "default",
+			},
+		},
+	}
+	u.SetGroupVersionKind(gvk)
+	if spec != nil {
+		u.Object["spec"] = spec
+	}
+
+	return u
+}
+
+func Test_newMergeFunc(t *testing.T) {
+	testCases := []struct {
+		name           string
+		fields         []string
+		current        map[string]interface{}
+		desired        map[string]interface{}
+		expectedMerged map[string]interface{}
+	}{
+		{
+			name:   "case 0: only allowed fields are copied",
+			fields: []string{"spec.replicas"},
+			current: map[string]interface{}{
+				"replicas": int64(1),
+				"paused":   true,
+			},
+			desired: map[string]interface{}{
+				"replicas": int64(3),
+				"paused":   false,
+			},
+			expectedMerged: map[string]interface{}{
+				"replicas": int64(3),
+				"paused":   true,
+			},
+		},
+		{
+			name:   "case 1: fields missing in the desired object are removed",
+			fields: []string{"spec.replicas"},
+			current: map[string]interface{}{
+				"replicas": int64(1),
+				"paused":   true,
+			},
+			desired: map[string]interface{}{},
+			expectedMerged: map[string]interface{}{
+				"paused": true,
+			},
+		},
+		{
+			name:   "case 2: non-map parents are replaced",
+			fields: []string{"spec.template.image"},
+			current: map[string]interface{}{
+				"template": "legacy",
+			},
+			desired: map[string]interface{}{
+				"template": map[string]interface{}{
+					"image": "alpine",
+				},
+			},
+			expectedMerged: map[string]interface{}{
+				"template": map[string]interface{}{
+					"image": "alpine",
+				},
+			},
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			var fields [][]string
+			for _, f := range tc.fields {
+				fields = append(fields, strings.Split(f, "."))
+			}
+
+			current := newTestObject(testGVK, tc.current)
+			desired := newTestObject(testGVK, tc.desired)
+
+			merged := newMergeFunc(fields)(current, desired)
+
+			if !reflect.DeepEqual(merged.Object["spec"], tc.expectedMerged) {
+				t.Fatalf("spec == %v, want %v", merged.Object["spec"], tc.expectedMerged)
+			}
+			if !reflect.DeepEqual(current, newTestObject(testGVK, tc.current)) {
+				t.Fatalf("current object must not be modified")
+			}
+		})
+	}
+}
+
+func Test_Resource_EnsureCreated(t *testing.T) {
+	testCases := []struct {
+		name         string
+		current      *unstructured.Unstructured
+		desired      *unstructured.Unstructured
+		expectedSpec map[string]interface{}
+		errorMatcher func(error) bool
+	}{
+		{
+			name:         "case 0: object is created",
+			desired:      newTestObject(schema.GroupVersionKind{}, map[string]interface{}{"replicas": int64(3)}),
+			expectedSpec: map[string]interface{}{"replicas": int64(3)},
+		},
+		{
+			name:         "case 1: object is updated",
+			current:      newTestObject(testGVK, map[string]interface{}{"replicas": int64(1), "paused": true}),
+			desired:      newTestObject(testGVK, map[string]interface{}{"replicas": int64(3), "paused": true}),
+			expectedSpec: map[string]interface{}{"replicas": int64(3), "paused": true},
+		},
+		{
+			name:         "case 2: object of different kind is rejected",
+			desired:      newTestObject(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, nil),
+			errorMatcher: IsWrongTypeError,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			ctx := context.Background()
+
+			builder := fake.NewClientBuilder()
+			if tc.current != nil {
+				builder = builder.WithObjects(tc.current)
+			}
+			ctrlClient := builder.Build()
+
+			stateGetter := &testStateGetter{
+				ctrlClient: ctrlClient,
+				desired:    []*unstructured.Unstructured{tc.desired},
+			}
+
+			var r *crud.Resource
+			{
+				c := Config{
+					CtrlClient:  ctrlClient,
+					Logger:      microloggertest.New(),
+					StateGetter: stateGetter,
+
+					Fields:           []string{"spec.replicas"},
+					GroupVersionKind: testGVK,
+					Name:             "test",
+				}
+
+				u, err := New(c)
+				if err != nil {
+					t.Fatalf("error == %#v, want nil", err)
+				}
+
+				r, err = crud.NewResource(crud.ResourceConfig{CRUD: u, Logger: microloggertest.New()})
+				if err != nil {
+					t.Fatalf("error == %#v, want nil", err)
+				}
+			}
+
+			err := r.EnsureCreated(ctx, nil)
+
+			switch {
+			case err == nil && tc.errorMatcher == nil:
+				// correct; carry on
+			case err != nil && tc.errorMatcher == nil:
+				t.Fatalf("error == %#v, want nil", err)
+			case err == nil && tc.errorMatcher != nil:
+				t.Fatalf("error == nil, want non-nil")
+			case !tc.errorMatcher(err):
+				t.Fatalf("error == %#v, want matching", err)
+			}
+
+			if tc.errorMatcher != nil {
+				return
+			}
+
+			u := &unstructured.Unstructured{}
+			u.SetGroupVersionKind(testGVK)
+			err = ctrlClient.Get(ctx, client.ObjectKey{Name: "test", Namespace: "default"}, u)
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+			if !reflect.DeepEqual(u.Object["spec"], tc.expectedSpec) {
+				t.Fatalf("spec == %v, want %v", u.Object["spec"], tc.expectedSpec)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package unstructuredresource
+
+import (
+	"context"
+
+	"github.com/giantswarm/microerror"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/giantswarm/operatorkit/v7/pkg/resource/k8s/objectresource"
+)
+
+// kindStateGetter ensures all objects returned by the underlying state getter
+// are of the configured kind.
+type kindStateGetter struct {
+	gvk        schema.GroupVersionKind
+	underlying objectresource.StateGetter[*unstructured.Unstructured]
+}
+
+func (g *kindStateGetter) GetCurrentState(ctx context.Context, obj interface{}) ([]*unstructured.Unstructured, error) {
+	objects, err := g.underlying.GetCurrentState(ctx, obj)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	err = g.ensureKind(objects)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return objects, nil
+}
+
+func (g *kindStateGetter) GetDesiredState(ctx context.Context, obj interface{}) ([]*unstructured.Unstructured, error) {
+	objects, err := g.underlying.GetDesiredState(ctx, obj)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	err = g.ensureKind(objects)
+	if err != nil {
+		return nil, microerror.Mask(err)
+	}
+
+	return objects, nil
+}
+
+func (g *kindStateGetter) ensureKind(objects []*unstructured.Unstructured) error {
+	for _, o := range objects {
+		gvk := o.GroupVersionKind()
+		if gvk.Empty() {
+			o.SetGroupVersionKind(g.gvk)
+			continue
+		}
+
+		if gvk != g.gvk {
+			return microerror.Maskf(wrongTypeError, "expected %#q, got %#q for object %#q in namespace %#q", g.gvk.String(), gvk.String(), o.GetName(), o.GetNamespace())
+		}
+	}
+
+	return nil
+}
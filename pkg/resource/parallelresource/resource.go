@@ -0,0 +1,168 @@
+// Package parallelresource executes a group of independent resources
+// concurrently within the same reconciliation loop.
+package parallelresource
+
+import (
+	"context"
+	"sync"
+
+	"github.com/giantswarm/microerror"
+	"github.com/giantswarm/micrologger/loggermeta"
+
+	"github.com/giantswarm/operatorkit/v7/pkg/controller/context/reconciliationcanceledcontext"
+	"github.com/giantswarm/operatorkit/v7/pkg/controller/context/resourcecanceledcontext"
+	"github.com/giantswarm/operatorkit/v7/pkg/resource"
+)
+
+const (
+	loggerKeyResource = "resource"
+)
+
+type Config struct {
+	// Name is the name of the resource group used for identification, e.g. in
+	// logging and metrics components.
+	Name string
+	// Resources is the group of independent resources executed concurrently.
+	// Resources depending on the group being reconciled must be configured
+	// after the group in the controller's chain, so that they are executed
+	// once all resources of the group succeeded.
+	Resources []resource.Interface
+}
+
+// Resource executes a group of independent resources concurrently. The
+// resources of the group must not depend on each other. Each of them gets its
+// own resource cancelation and logger meta, so that canceling one resource
+// does not affect the others. Canceling the reconciliation is still visible to
+// all resources of the group. The first error returned by any resource of the
+// group cancels all other resources of the group and is returned once all of
+// them finished. The controller only instruments the group as a whole, see
+// metricsresource for instrumenting the resources of the group individually.
+type Resource struct {
+	name      string
+	resources []resource.Interface
+}
+
+func New(config Config) (*Resource, error) {
+	if config.Name == "" {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Name must not be empty", config)
+	}
+	if len(config.Resources) == 0 {
+		return nil, microerror.Maskf(invalidConfigError, "%T.Resources must not be empty", config)
+	}
+	for i, r := range config.Resources {
+		if r == nil {
+			return nil, microerror.Maskf(invalidConfigError, "%T.Resources[%d] must not be empty", config, i)
+		}
+	}
+
+	r := &Resource{
+		name:      config.Name,
+		resources: config.Resources,
+	}
+
+	return r, nil
+}
+
+func (r *Resource) EnsureCreated(ctx context.Context, obj interface{}) error {
+	err := r.execute(ctx, func(ctx context.Context, res resource.Interface) error {
+		return res.EnsureCreated(ctx, obj)
+	})
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func (r *Resource) EnsureDeleted(ctx context.Context, obj interface{}) error {
+	err := r.execute(ctx, func(ctx context.Context, res resource.Interface) error {
+		return res.EnsureDeleted(ctx, obj)
+	})
+	if err != nil {
+		return microerror.Mask(err)
+	}
+
+	return nil
+}
+
+func (r *Resource) Name() string {
+	return r.name
+}
+
+// Resources returns the group of resources executed concurrently.
+func (r *Resource) Resources() []resource.Interface {
+	return r.resources
+}
+
+func (r *Resource) execute(ctx context.Context, fn func(ctx context.Context, res resource.Interface) error) error {
+	if reconciliationcanceledcontext.IsCanceled(ctx) {
+		return nil
+	}
+	if resourcecanceledcontext.IsCanceled(ctx) {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var ctxs []context.Context
+	for _, res := range r.resources {
+		ctxs = append(ctxs, newResourceCtx(ctx, res.Name()))
+	}
+
+	var errOnce sync.Once
+	var firstErr error
+	var wg sync.WaitGroup
+
+	for i, res := range r.resources {
+		wg.Add(1)
+
+		go func(ctx context.Context, res resource.Interface) {
+			defer wg.Done()
+
+			err := fn(ctx, res)
+			if err != nil {
+				errOnce.Do(func() {
+					firstErr = err
+
+					// Siblings are marked as canceled before their
+					// context is canceled so that they observe the
+					// cancelation flag once they see ctx.Done().
+					for _, c := range ctxs {
+						resourcecanceledcontext.SetCanceled(c)
+					}
+					cancel()
+				})
+			}
+		}(ctxs[i], res)
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return microerror.Mask(firstErr)
+	}
+
+	return nil
+}
+
+// newResourceCtx returns a new context carrying a resource cancelation of its
+// own and a copy of the logger meta found in ctx, if any, annotated with the
+// given resource name. Resources modify the logger meta during execution, which
+// is why they must not share it when being executed concurrently.
+func newResourceCtx(ctx context.Context, name string) context.Context {
+	m := loggermeta.New()
+
+	o, ok := loggermeta.FromContext(ctx)
+	if ok {
+		for k, v := range o.KeyVals {
+			m.KeyVals[k] = v
+		}
+	}
+	m.KeyVals[loggerKeyResource] = name
+
+	ctx = loggermeta.NewContext(ctx, m)
+	ctx = resourcecanceledcontext.NewContext(ctx, make(chan struct{}))
+
+	return ctx
+}
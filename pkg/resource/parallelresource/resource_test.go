@@ -0,0 +1,186 @@
+package parallelresource
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/giantswarm/micrologger/loggermeta"
+
+	"github.com/giantswarm/operatorkit/v7/pkg/controller/context/reconciliationcanceledcontext"
+	"github.com/giantswarm/operatorkit/v7/pkg/controller/context/resourcecanceledcontext"
+	"github.com/giantswarm/operatorkit/v7/pkg/resource"
+)
+
+func Test_Resource_Interface(t *testing.T) {
+	var _ resource.Interface = &Resource{}
+}
+
+func Test_Resource_EnsureCreated(t *testing.T) {
+	testError := errors.New("test error")
+
+	testCases := []struct {
+		name             string
+		ctx              func() context.Context
+		resources        func(started *sync.WaitGroup) []*testResource
+		expectedExecuted []bool
+		expectedCanceled []bool
+		expectedErr      error
+	}{
+		{
+			name: "case 0: resources are executed concurrently",
+			ctx: func() context.Context {
+				return context.Background()
+			},
+			resources: func(started *sync.WaitGroup) []*testResource {
+				// Each resource waits for all resources to be started, which
+				// only succeeds when they are executed concurrently.
+				started.Add(3)
+				return []*testResource{
+					{name: "a", started: started},
+					{name: "b", started: started},
+					{name: "c", started: started},
+				}
+			},
+			expectedExecuted: []bool{true, true, true},
+			expectedCanceled: []bool{false, false, false},
+			expectedErr:      nil,
+		},
+		{
+			name: "case 1: the first error cancels all other resources",
+			ctx: func() context.Context {
+				return context.Background()
+			},
+			resources: func(started *sync.WaitGroup) []*testResource {
+				return []*testResource{
+					{name: "a", err: testError},
+					{name: "b", waitCanceled: true},
+					{name: "c", waitCanceled: true},
+				}
+			},
+			expectedExecuted: []bool{true, true, true},
+			expectedCanceled: []bool{false, true, true},
+			expectedErr:      testError,
+		},
+		{
+			name: "case 2: resources are not executed when the reconciliation is canceled",
+			ctx: func() context.Context {
+				ctx := reconciliationcanceledcontext.NewContext(context.Background(), make(chan struct{}))
+				reconciliationcanceledcontext.SetCanceled(ctx)
+				return ctx
+			},
+			resources: func(started *sync.WaitGroup) []*testResource {
+				return []*testResource{
+					{name: "a"},
+					{name: "b"},
+				}
+			},
+			expectedExecuted: []bool{false, false},
+			expectedCanceled: []bool{false, false},
+			expectedErr:      nil,
+		},
+		{
+			name: "case 3: resource cancelation does not affect other resources",
+			ctx: func() context.Context {
+				return context.Background()
+			},
+			resources: func(started *sync.WaitGroup) []*testResource {
+				return []*testResource{
+					{name: "a", cancel: true},
+					{name: "b"},
+				}
+			},
+			expectedExecuted: []bool{true, true},
+			expectedCanceled: []bool{true, false},
+			expectedErr:      nil,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			t.Log(tc.name)
+
+			testResources := tc.resources(&sync.WaitGroup{})
+
+			var resources []resource.Interface
+			for _, r := range testResources {
+				resources = append(resources, r)
+			}
+
+			r, err := New(Config{Name: "test", Resources: resources})
+			if err != nil {
+				t.Fatalf("error == %#v, want nil", err)
+			}
+
+			ctx := loggermeta.NewContext(tc.ctx(), loggermeta.New())
+
+			err = r.EnsureCreated(ctx, nil)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("error == %#v, want %#v", err, tc.expectedErr)
+			}
+
+			for j, res := range testResources {
+				if res.executed != tc.expectedExecuted[j] {
+					t.Fatalf("resource %s executed == %v, want %v", res.name, res.executed, tc.expectedExecuted[j])
+				}
+				if res.canceled != tc.expectedCanceled[j] {
+					t.Fatalf("resource %s canceled == %v, want %v", res.name, res.canceled, tc.expectedCanceled[j])
+				}
+				if res.executed && res.loggerResource != res.name {
+					t.Fatalf("resource %s logger meta resource == %q, want %q", res.name, res.loggerResource, res.name)
+				}
+			}
+		})
+	}
+}
+
+type testResource struct {
+	name         string
+	cancel       bool
+	err          error
+	started      *sync.WaitGroup
+	waitCanceled bool
+
+	canceled       bool
+	executed       bool
+	loggerResource string
+}
+
+func (r *testResource) EnsureCreated(ctx context.Context, obj interface{}) error {
+	r.executed = true
+
+	m, _ := loggermeta.FromContext(ctx)
+	m.KeyVals["function"] = r.name
+	r.loggerResource = m.KeyVals[loggerKeyResource]
+
+	if r.started != nil {
+		r.started.Done()
+		r.started.Wait()
+	}
+
+	if r.cancel {
+		resourcecanceledcontext.SetCanceled(ctx)
+	}
+
+	if r.waitCanceled {
+		select {
+		case <-ctx.Done():
+		case <-time.After(5 * time.Second):
+		}
+	}
+
+	r.canceled = resourcecanceledcontext.IsCanceled(ctx)
+
+	return r.err
+}
+
+func (r *testResource) EnsureDeleted(ctx context.Context, obj interface{}) error {
+	return nil
+}
+
+func (r *testResource) Name() string {
+	return r.name
+}
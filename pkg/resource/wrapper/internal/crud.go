@@ -17,3 +17,19 @@ func CRUD(r resource.Interface) (crud.Interface, bool) {
 
 	return nil, false
 }
+
+// WithCRUD returns a copy of the given resource executing the given CRUD
+// resource instead, so that the configuration of the original resource, e.g.
+// its logger and event recorder, is retained when wrapping it.
+func WithCRUD(r resource.Interface, c crud.Interface) (resource.Interface, bool) {
+	type withCRUDer interface {
+		WithCRUD(c crud.Interface) *crud.Resource
+	}
+
+	w, ok := r.(withCRUDer)
+	if ok {
+		return w.WithCRUD(c), true
+	}
+
+	return nil, false
+}
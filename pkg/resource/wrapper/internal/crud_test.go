@@ -29,3 +29,33 @@ func Test_CRUD_failure(t *testing.T) {
 		t.Fatalf("CURD(r) == %v, want %v", ok, false)
 	}
 }
+
+func Test_WithCRUD_success(t *testing.T) {
+	// For wrapping resources to retain their configuration WithCRUD must be
+	// able to replace crud.Interface of *crud.Resource.
+
+	r := test.NewNopCRUDResource()
+	c := test.NewNopCRUD()
+
+	wrapped, ok := WithCRUD(r, c)
+	if !ok {
+		t.Fatalf("WithCRUD(r, c) == %v, want %v", ok, true)
+	}
+	extractedCRUD, ok := CRUD(wrapped)
+	if !ok {
+		t.Fatalf("CURD(wrapped) == %v, want %v", ok, true)
+	}
+	if extractedCRUD != c {
+		t.Fatalf("extractedCRUD == %v, want %v", extractedCRUD, c)
+	}
+}
+
+func Test_WithCRUD_failure(t *testing.T) {
+	r := test.NewNopBasicResource()
+	c := test.NewNopCRUD()
+
+	_, ok := WithCRUD(r, c)
+	if ok {
+		t.Fatalf("WithCRUD(r, c) == %v, want %v", ok, false)
+	}
+}
@@ -33,6 +33,12 @@ func (r *crudResource) Name() string {
 	return r.crud.Name()
 }
 
+// DryRun forwards crud.DryRunner of the wrapped CRUD resource, if implemented.
+func (r *crudResource) DryRun() bool {
+	d, ok := r.crud.(crud.DryRunner)
+	return ok && d.DryRun()
+}
+
 func (r *crudResource) GetCurrentState(ctx context.Context, obj interface{}) (interface{}, error) {
 	rl := r.crud.Name()
 	ol := "GetCurrentState"
@@ -47,6 +47,13 @@ func New(config Config) (resource.Interface, error) {
 			}
 		}
 
+		{
+			r, ok := internal.WithCRUD(config.Resource, wrappedCRUD)
+			if ok {
+				return r, nil
+			}
+		}
+
 		{
 			c := crud.ResourceConfig{
 				CRUD:   wrappedCRUD,